@@ -0,0 +1,81 @@
+// Command admin serves the internal dashboard used to manage performances
+// and biography content shown on the public site.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/adamkadda/ntumiwa/internal/apiclient"
+	"github.com/adamkadda/ntumiwa/internal/buildinfo"
+	"github.com/adamkadda/ntumiwa/internal/config"
+	"github.com/adamkadda/ntumiwa/internal/logging"
+)
+
+func main() {
+	var (
+		port     = flag.Int("port", 0, "port to listen on (overrides env)")
+		logLevel = flag.String("log-level", "", "log level: debug, info, warn, error")
+		version  = flag.Bool("version", false, "print version information and exit")
+		printCfg = flag.Bool("print-config", false, "print the effective config, with secrets redacted, and exit")
+	)
+	flag.Parse()
+
+	if *version {
+		fmt.Println(buildinfo.String("admin"))
+		os.Exit(0)
+	}
+
+	cfg, err := config.LoadConfig(config.KindAdmin, "")
+	if err != nil {
+		log.Fatalf("admin: load config: %v", err)
+	}
+	if *port != 0 {
+		cfg.Frontend.Port = *port
+	}
+	if *logLevel != "" {
+		cfg.Log.Level = *logLevel
+	}
+	if err := logging.Setup(logging.Config{
+		Output:       cfg.Log.Output,
+		FilePath:     cfg.Log.FilePath,
+		MaxSizeMB:    cfg.Log.MaxSizeMB,
+		MaxBackups:   cfg.Log.MaxBackups,
+		SyslogAddr:   cfg.Log.SyslogAddr,
+		OTLPEndpoint: cfg.Log.OTLPEndpoint,
+	}); err != nil {
+		log.Fatalf("admin: set up logging: %v", err)
+	}
+
+	if *printCfg {
+		b, err := json.MarshalIndent(cfg.Redact(), "", "  ")
+		if err != nil {
+			log.Fatalf("admin: marshal config: %v", err)
+		}
+		fmt.Println(string(b))
+		os.Exit(0)
+	}
+
+	client := apiclient.New(apiclient.Config{BaseURL: cfg.Frontend.APIBaseURL})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/performances", func(w http.ResponseWriter, r *http.Request) {
+		performances, err := client.GetPerformances(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_ = json.NewEncoder(w).Encode(performances)
+	})
+
+	addr := fmt.Sprintf(":%d", cfg.Frontend.Port)
+	log.Printf("admin: listening on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("admin: %v", err)
+	}
+}
@@ -0,0 +1,426 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/adamkadda/ntumiwa/internal/apiclient/gen"
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/buildinfo"
+	"github.com/adamkadda/ntumiwa/internal/config"
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/httpjson"
+	"github.com/adamkadda/ntumiwa/internal/locale"
+	"github.com/adamkadda/ntumiwa/internal/ratelimit"
+)
+
+// TODO: handleBiography is a placeholder in-memory response until the db
+// layer grows a biography resource.
+
+// handlePerformances serves the public performances listing straight from
+// performances_view, so it's a single cheap SELECT no matter how large
+// programmes and their pieces get. Each performance carries its tour_id
+// and tour_name when it belongs to one, so a multi-city tour still reads
+// as one entity to a client that groups the flat list by tour_id, without
+// this endpoint's response shape stopping being a plain array. season_id
+// and season_name work the same way, grouping performances by the season
+// their date falls in. TextDate is rendered in the caller's language: an
+// explicit ?locale= query parameter wins, falling back to Accept-Language,
+// falling back to English if neither names a locale this package supports.
+func handlePerformances(database *db.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		loc := locale.Default
+		if raw := r.URL.Query().Get("locale"); raw != "" {
+			loc = locale.Parse(raw)
+		} else if raw := r.Header.Get("Accept-Language"); raw != "" {
+			loc = locale.FromAcceptLanguage(raw)
+		}
+
+		rows, err := database.ListPerformances(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		performances := make([]gen.Performance, len(rows))
+		for i, row := range rows {
+			offers := make([]gen.TicketOffer, len(row.TicketOffers))
+			for j, offer := range row.TicketOffers {
+				offers[j] = gen.TicketOffer{
+					Label:      offer.Label,
+					Url:        offer.URL,
+					PriceCents: offer.PriceCents,
+					Currency:   offer.Currency,
+					Status:     offer.Status.String(),
+				}
+			}
+			media := make([]gen.EventMedia, len(row.Media))
+			for j, m := range row.Media {
+				media[j] = gen.EventMedia{
+					Kind:  m.Kind,
+					Url:   m.URL,
+					Label: m.Label,
+				}
+			}
+			collaborators := make([]gen.EventCollaborator, len(row.Collaborators))
+			for j, c := range row.Collaborators {
+				collaborators[j] = gen.EventCollaborator{
+					Name: c.Name,
+					Role: c.Role,
+				}
+			}
+			performances[i] = gen.Performance{
+				Id:                 row.ID,
+				Title:              row.Title,
+				Venue:              row.Venue,
+				TextDate:           locale.FormatDate(row.EventDate, loc),
+				TourId:             row.TourID,
+				TourName:           row.TourName,
+				SeasonId:           row.SeasonID,
+				SeasonName:         row.SeasonName,
+				Status:             row.Status.String(),
+				CancellationReason: row.CancellationReason,
+				TicketOffers:       offers,
+				Media:              media,
+				Collaborators:      collaborators,
+			}
+		}
+		_ = httpjson.StreamArray(w, http.StatusOK, performances, httpjson.Fields(r))
+	}
+}
+
+// handlePerformanceStats serves the aggregate figures behind a "by the
+// numbers" page: events per year, the most-performed composers and
+// pieces, and venues by country. It's public, unlike the /admin/db/stats
+// pool diagnostics, and cached the same way /performances is since the
+// underlying grouped queries scan every published event.
+func handlePerformanceStats(database *db.DB) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		stats, err := database.PerformanceStats(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		eventsPerYear := make([]gen.EventsPerYear, len(stats.EventsPerYear))
+		for i, y := range stats.EventsPerYear {
+			eventsPerYear[i] = gen.EventsPerYear{Year: y.Year, Count: y.Count}
+		}
+		topComposers := make([]gen.ComposerCount, len(stats.TopComposers))
+		for i, c := range stats.TopComposers {
+			topComposers[i] = gen.ComposerCount{ComposerId: c.ComposerID, Name: c.Name, Count: c.Count}
+		}
+		topPieces := make([]gen.PieceCount, len(stats.TopPieces))
+		for i, p := range stats.TopPieces {
+			topPieces[i] = gen.PieceCount{PieceId: p.PieceID, Title: p.Title, Count: p.Count}
+		}
+		venuesByCountry := make([]gen.VenueCountryCount, len(stats.VenuesByCountry))
+		for i, v := range stats.VenuesByCountry {
+			venuesByCountry[i] = gen.VenueCountryCount{Country: v.Country, Count: v.Count}
+		}
+
+		httpjson.Respond(w, http.StatusOK, gen.PerformanceStats{
+			EventsPerYear:   eventsPerYear,
+			TopComposers:    topComposers,
+			TopPieces:       topPieces,
+			VenuesByCountry: venuesByCountry,
+		})
+	}
+}
+
+// statusResponse is the body of GET /status: everything needed to verify
+// what's actually deployed without shell access to the host.
+type statusResponse struct {
+	Version    string   `json:"version"`
+	Commit     string   `json:"commit"`
+	BuildTime  string   `json:"build_time"`
+	GoVersion  string   `json:"go_version"`
+	UptimeSecs float64  `json:"uptime_seconds"`
+	Features   []string `json:"features"`
+}
+
+// registerDebugHandlers mounts net/http/pprof and an expvar runtime stats
+// endpoint under /debug/, each gated by admin authentication. Only called
+// when server.enable_pprof is set, since these expose stack traces and
+// memory contents.
+func registerDebugHandlers(mux *http.ServeMux, store *config.Store) {
+	mux.HandleFunc("/debug/pprof/", adminOnly(store, pprof.Index))
+	mux.HandleFunc("/debug/pprof/cmdline", adminOnly(store, pprof.Cmdline))
+	mux.HandleFunc("/debug/pprof/profile", adminOnly(store, pprof.Profile))
+	mux.HandleFunc("/debug/pprof/symbol", adminOnly(store, pprof.Symbol))
+	mux.HandleFunc("/debug/pprof/trace", adminOnly(store, pprof.Trace))
+	mux.Handle("/debug/vars", adminOnlyHandler(store, expvar.Handler()))
+}
+
+// adminOnly wraps handler so it 403s unless isAdminRequest passes.
+func adminOnly(store *config.Store, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !isAdminRequest(r, store.Get()) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// adminOnlyHandler is adminOnly for an http.Handler rather than a
+// http.HandlerFunc, for wrapping handlers like expvar.Handler() that
+// aren't already a plain func.
+func adminOnlyHandler(store *config.Store, handler http.Handler) http.HandlerFunc {
+	return adminOnly(store, handler.ServeHTTP)
+}
+
+func handleStatus(w http.ResponseWriter, r *http.Request) {
+	httpjson.Respond(w, http.StatusOK, statusResponse{
+		Version:    buildinfo.Version,
+		Commit:     buildinfo.Commit,
+		BuildTime:  buildinfo.BuildTime,
+		GoVersion:  runtime.Version(),
+		UptimeSecs: buildinfo.Uptime().Seconds(),
+		Features:   buildinfo.EnabledFeatures(),
+	})
+}
+
+func handleBiography(w http.ResponseWriter, r *http.Request) {
+	httpjson.Respond(w, http.StatusOK, gen.Biography{
+		Body:      "Nadia Tumiwa is a concert pianist.",
+		UpdatedAt: "2026-01-01T00:00:00Z",
+	})
+}
+
+// handleConfigReload lets an authenticated admin trigger the same reload
+// that SIGHUP does, without needing shell access to the host.
+func handleConfigReload(store *config.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !isAdminRequest(r, store.Get()) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if _, err := store.Reload(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleConfigShow answers "what is this instance actually running with?"
+// during incidents, with secrets redacted.
+func handleConfigShow(store *config.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cfg := store.Get()
+		if !isAdminRequest(r, cfg) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		httpjson.Respond(w, http.StatusOK, cfg.Redact())
+	}
+}
+
+// handleDBStats reports connection pool utilization, for diagnosing
+// exhaustion under load.
+func handleDBStats(database *db.DB, store *config.Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !isAdminRequest(r, store.Get()) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		httpjson.Respond(w, http.StatusOK, map[string]db.PoolStats{
+			"primary": database.Stats(),
+			"replica": database.ReplicaStats(),
+		})
+	}
+}
+
+// handleExport dumps the entire catalogue as a versioned JSON archive, for
+// backups and environment cloning.
+func handleExport(database *db.DB, store *config.Store, limiter *ratelimit.Limiter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cfg := store.Get()
+		if !isAdminRequest(r, cfg) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if !checkActorRateLimit(w, r, limiter, cfg) {
+			return
+		}
+		archive, err := database.Export(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Disposition", `attachment; filename="ntumiwa-export.json"`)
+		httpjson.Respond(w, http.StatusOK, archive)
+	}
+}
+
+// maxImportBytes bounds a restored archive comfortably above a full
+// catalogue dump, so an admin-authenticated caller with a huge or
+// malformed body still can't OOM the process decoding it.
+const maxImportBytes = 128 << 20 // 128MB
+
+// handleImport restores a JSON archive previously produced by handleExport.
+func handleImport(database *db.DB, store *config.Store, limiter *ratelimit.Limiter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		cfg := store.Get()
+		if !isAdminRequest(r, cfg) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if !checkActorRateLimit(w, r, limiter, cfg) {
+			return
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxImportBytes)
+		var archive db.Archive
+		if err := json.NewDecoder(r.Body).Decode(&archive); err != nil {
+			http.Error(w, "invalid or oversized archive body", http.StatusBadRequest)
+			return
+		}
+		if err := database.Import(r.Context(), &archive); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// handleJobs serves the dead-letter queue admin endpoints under
+// /admin/jobs/: listing dead jobs and retrying or deleting them, so
+// recovering a stuck job doesn't require psql access.
+func handleJobs(database *db.DB, store *config.Store, limiter *ratelimit.Limiter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cfg := store.Get()
+		if !isAdminRequest(r, cfg) {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+		if !checkActorRateLimit(w, r, limiter, cfg) {
+			return
+		}
+
+		rest := strings.TrimPrefix(r.URL.Path, "/admin/jobs/")
+		switch rest {
+		case "dead":
+			handleJobsDead(database, w, r)
+			return
+		case "dead/retry":
+			handleJobsRetryDead(database, w, r)
+			return
+		}
+
+		idStr, action, hasAction := strings.Cut(rest, "/")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			http.Error(w, "invalid job id", http.StatusBadRequest)
+			return
+		}
+		switch {
+		case hasAction && action == "retry" && r.Method == http.MethodPost:
+			handleJobRetry(database, w, r, id)
+		case !hasAction && r.Method == http.MethodDelete:
+			handleJobDelete(database, w, r, id)
+		default:
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}
+}
+
+func handleJobsDead(database *db.DB, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	jobs, err := database.ListDeadJobs(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, jobs)
+}
+
+func handleJobsRetryDead(database *db.DB, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	n, err := database.RetryDeadJobs(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, map[string]int{"retried": n})
+}
+
+func handleJobRetry(database *db.DB, w http.ResponseWriter, r *http.Request, id int64) {
+	if err := database.RetryJob(r.Context(), id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func handleJobDelete(database *db.DB, w http.ResponseWriter, r *http.Request, id int64) {
+	if err := database.DeleteJob(r.Context(), id); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isAdminRequest is a placeholder authentication check until the admin
+// session subsystem lands; it compares a bearer token against SecretKey.
+// Both sides are hashed to a fixed length before the constant-time
+// comparison, so neither the token's length nor which byte first
+// mismatches leaks through response timing.
+func isAdminRequest(r *http.Request, cfg *config.Config) bool {
+	if cfg.SecretKey == "" {
+		return false
+	}
+	got := sha256.Sum256([]byte(r.Header.Get("Authorization")))
+	want := sha256.Sum256([]byte("Bearer " + cfg.SecretKey))
+	return subtle.ConstantTimeCompare(got[:], want[:]) == 1
+}
+
+// checkActorRateLimit quotas an admin request by the caller's actor
+// identity (auth.Actor, set from the unverified X-Actor header), writing
+// X-RateLimit-* headers and a 429 on rejection. It must only be called
+// after isAdminRequest has already succeeded: actor identity isn't a
+// verified credential, so a caller could otherwise defeat the quota by
+// sending a different X-Actor on every request, and an unauthenticated
+// caller could grow the limiter's bucket map by minting fresh actors
+// with no valid SecretKey at all. actor's quota comes from
+// actor_rate_limit.roles if listed there, otherwise
+// actor_rate_limit.default.
+func checkActorRateLimit(w http.ResponseWriter, r *http.Request, limiter *ratelimit.Limiter, cfg *config.Config) bool {
+	quota := cfg.ActorRateLimit.Default
+	if role, ok := cfg.ActorRateLimit.Roles[auth.Actor(r.Context())]; ok {
+		quota = role
+	}
+
+	result := limiter.Allow(auth.Actor(r.Context()), ratelimit.Config{
+		RequestsPerSecond: quota.RequestsPerSecond,
+		Burst:             quota.Burst,
+	})
+	w.Header().Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(result.ResetAt.Unix(), 10))
+	if !result.Allowed {
+		http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,565 @@
+// Command api serves the ntumiwa API: the source of truth for performances,
+// biography and (eventually) the admin-managed content behind them.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/api"
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/buildinfo"
+	"github.com/adamkadda/ntumiwa/internal/config"
+	"github.com/adamkadda/ntumiwa/internal/cron"
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/errreport"
+	"github.com/adamkadda/ntumiwa/internal/fixtures"
+	"github.com/adamkadda/ntumiwa/internal/googlecalendar"
+	"github.com/adamkadda/ntumiwa/internal/icalimport"
+	"github.com/adamkadda/ntumiwa/internal/jobs"
+	"github.com/adamkadda/ntumiwa/internal/logging"
+	"github.com/adamkadda/ntumiwa/internal/mailer"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/newsletter"
+	"github.com/adamkadda/ntumiwa/internal/ratelimit"
+	"github.com/adamkadda/ntumiwa/internal/storage"
+	"github.com/adamkadda/ntumiwa/internal/streamingmeta"
+	"github.com/adamkadda/ntumiwa/internal/tickets"
+	"github.com/adamkadda/ntumiwa/internal/webhooks"
+	"github.com/adamkadda/ntumiwa/internal/youtube"
+)
+
+func main() {
+	var (
+		port       = flag.Int("port", 0, "port to listen on (overrides config/env)")
+		configPath = flag.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+		logLevel   = flag.String("log-level", "", "log level: debug, info, warn, error")
+		migrate    = flag.Bool("migrate", false, "run pending migrations and exit")
+		seed       = flag.Bool("seed", false, "load sample development data and exit")
+		version    = flag.Bool("version", false, "print version information and exit")
+		printCfg   = flag.Bool("print-config", false, "print the effective config, with secrets redacted, and exit")
+		exportPath = flag.String("export", "", "write a JSON archive of the whole catalogue to this path and exit")
+		importPath = flag.String("import", "", "restore a JSON archive previously written by --export and exit")
+	)
+	flag.Parse()
+
+	if *version {
+		fmt.Println(buildinfo.String("api"))
+		os.Exit(0)
+	}
+
+	cfg, err := config.LoadConfig(config.KindAPI, *configPath)
+	if err != nil {
+		log.Fatalf("api: load config: %v", err)
+	}
+	if *port != 0 {
+		cfg.Server.Port = *port
+	}
+	if *logLevel != "" {
+		cfg.Log.Level = *logLevel
+	}
+	if err := logging.Setup(logging.Config{
+		Output:       cfg.Log.Output,
+		FilePath:     cfg.Log.FilePath,
+		MaxSizeMB:    cfg.Log.MaxSizeMB,
+		MaxBackups:   cfg.Log.MaxBackups,
+		SyslogAddr:   cfg.Log.SyslogAddr,
+		OTLPEndpoint: cfg.Log.OTLPEndpoint,
+	}); err != nil {
+		log.Fatalf("api: set up logging: %v", err)
+	}
+
+	if *printCfg {
+		b, err := json.MarshalIndent(cfg.Redact(), "", "  ")
+		if err != nil {
+			log.Fatalf("api: marshal config: %v", err)
+		}
+		fmt.Println(string(b))
+		os.Exit(0)
+	}
+
+	timeouts := db.Timeouts{
+		Read:   time.Duration(cfg.Postgres.Timeouts.ReadSeconds) * time.Second,
+		Write:  time.Duration(cfg.Postgres.Timeouts.WriteSeconds) * time.Second,
+		Report: time.Duration(cfg.Postgres.Timeouts.ReportSeconds) * time.Second,
+	}
+	database, err := db.Open(context.Background(), cfg.Postgres.DSN(), cfg.Postgres.ReadReplicaDSN(), timeouts)
+	if err != nil {
+		log.Fatalf("api: connect to postgres: %v", err)
+	}
+	defer database.Close()
+
+	if *migrate {
+		if err := database.Migrate(context.Background()); err != nil {
+			log.Fatalf("api: migrate: %v", err)
+		}
+		os.Exit(0)
+	}
+	if err := database.Migrate(context.Background()); err != nil {
+		log.Fatalf("api: migrate on startup: %v", err)
+	}
+
+	if *seed {
+		set, err := fixtures.Default()
+		if err != nil {
+			log.Fatalf("api: seed: %v", err)
+		}
+		stores := fixtures.Stores{Composers: database, Pieces: database, Programmes: database, Events: database}
+		if err := fixtures.Apply(context.Background(), stores, set); err != nil {
+			log.Fatalf("api: seed: %v", err)
+		}
+		os.Exit(0)
+	}
+
+	if *exportPath != "" {
+		archive, err := database.Export(context.Background())
+		if err != nil {
+			log.Fatalf("api: export: %v", err)
+		}
+		b, err := json.MarshalIndent(archive, "", "  ")
+		if err != nil {
+			log.Fatalf("api: export: marshal archive: %v", err)
+		}
+		if err := os.WriteFile(*exportPath, b, 0o644); err != nil {
+			log.Fatalf("api: export: write %s: %v", *exportPath, err)
+		}
+		os.Exit(0)
+	}
+
+	if *importPath != "" {
+		b, err := os.ReadFile(*importPath)
+		if err != nil {
+			log.Fatalf("api: import: read %s: %v", *importPath, err)
+		}
+		var archive db.Archive
+		if err := json.Unmarshal(b, &archive); err != nil {
+			log.Fatalf("api: import: parse %s: %v", *importPath, err)
+		}
+		if err := database.Import(context.Background(), &archive); err != nil {
+			log.Fatalf("api: import: %v", err)
+		}
+		os.Exit(0)
+	}
+
+	store := config.NewStore(config.KindAPI, *configPath, cfg)
+	store.WatchSIGHUP()
+
+	reporter, err := errreport.New(errreport.Config{
+		Kind:        cfg.ErrorReporting.Kind,
+		DSN:         cfg.ErrorReporting.DSN,
+		Environment: cfg.ErrorReporting.Environment,
+	})
+	if err != nil {
+		log.Fatalf("api: build error reporter: %v", err)
+	}
+	api.SetReporter(reporter)
+
+	hub := api.NewHub()
+	watchChannel(database, hub, db.ChannelEvents)
+	watchChannel(database, hub, db.ChannelProgrammes)
+
+	retention := time.Duration(cfg.Trash.RetentionDays) * 24 * time.Hour
+	scheduler := &cron.Scheduler{Locker: database}
+	if err := scheduler.Register(cron.Task{
+		Name: "purge",
+		Expr: cfg.Cron.PurgeSchedule,
+		Run: func(ctx context.Context) error {
+			n, err := database.PurgeExpired(ctx, retention)
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				log.Printf("api: purged %d trashed row(s)", n)
+			}
+			return nil
+		},
+	}); err != nil {
+		log.Fatalf("api: register cron task: %v", err)
+	}
+	if cfg.EventReminder.AdminEmail == "" {
+		log.Printf("api: event_reminder.admin_email not set, skipping reminder digest")
+	} else {
+		sender, err := mailer.New(mailer.Config{
+			Kind:        cfg.Mailer.Kind,
+			From:        cfg.Mailer.From,
+			SMTPHost:    cfg.Mailer.SMTPHost,
+			SMTPPort:    cfg.Mailer.SMTPPort,
+			SMTPUser:    cfg.Mailer.SMTPUser,
+			SMTPPwd:     cfg.Mailer.SMTPPwd,
+			APIEndpoint: cfg.Mailer.APIEndpoint,
+			APIKey:      cfg.Mailer.APIKey,
+		})
+		if err != nil {
+			log.Fatalf("api: build mailer: %v", err)
+		}
+		digestTemplate, err := mailer.LoadTemplate("event_digest", "Upcoming events needing attention")
+		if err != nil {
+			log.Fatalf("api: load event digest template: %v", err)
+		}
+		leadTime := time.Duration(cfg.EventReminder.LeadDays) * 24 * time.Hour
+		if err := scheduler.Register(cron.Task{
+			Name: "event-reminders",
+			Expr: cfg.Cron.EventReminderSchedule,
+			Run: func(ctx context.Context) error {
+				return sendEventReminders(ctx, database, sender, digestTemplate, cfg.EventReminder, leadTime)
+			},
+		}); err != nil {
+			log.Fatalf("api: register cron task: %v", err)
+		}
+	}
+	ticketRefresher := &tickets.Refresher{Store: database, Prober: &tickets.GenericProber{}}
+	if err := scheduler.Register(cron.Task{
+		Name: "ticket-status",
+		Expr: cfg.Cron.TicketStatusSchedule,
+		Run:  ticketRefresher.Refresh,
+	}); err != nil {
+		log.Fatalf("api: register cron task: %v", err)
+	}
+	if cfg.CalendarImport.URL == "" {
+		log.Printf("api: calendar_import.url not set, skipping calendar hold import")
+	} else {
+		importer := &icalimport.Importer{Store: database, URL: cfg.CalendarImport.URL}
+		if err := scheduler.Register(cron.Task{
+			Name: "calendar-import",
+			Expr: cfg.Cron.CalendarImportSchedule,
+			Run:  importer.Sync,
+		}); err != nil {
+			log.Fatalf("api: register cron task: %v", err)
+		}
+	}
+	// Additional scheduled tasks (auto-archive, scheduled publish, session
+	// GC, cache warmup) register here as those subsystems are built.
+	go scheduler.Run(context.Background())
+
+	if cfg.GoogleCalendar.CalendarID == "" || cfg.GoogleCalendar.Token == "" {
+		log.Printf("api: google_calendar.calendar_id or token not set, skipping Google Calendar sync")
+	} else {
+		syncer := &googlecalendar.Syncer{
+			Store:      database,
+			Client:     &googlecalendar.APIClient{Token: cfg.GoogleCalendar.Token},
+			CalendarID: cfg.GoogleCalendar.CalendarID,
+		}
+		pool := &jobs.Pool{
+			Store:       database,
+			Handlers:    map[string]jobs.Handler{db.JobKindGoogleCalendarSync: syncer.Handle},
+			WorkerID:    "api",
+			Concurrency: cfg.GoogleCalendar.WorkerConcurrency,
+		}
+		go pool.Run(context.Background())
+	}
+
+	webhookPoster := &webhooks.Poster{Store: database, Sender: &webhooks.HTTPSender{}}
+	webhookPool := &jobs.Pool{
+		Store:       database,
+		Handlers:    map[string]jobs.Handler{db.JobKindWebhookSocialPost: webhookPoster.Handle},
+		WorkerID:    "api",
+		Concurrency: 1,
+	}
+	go webhookPool.Run(context.Background())
+
+	// No streaming provider has real API credentials wired up yet, so every
+	// provider gets an UnconfiguredClient: a link still gets a job, and that
+	// job still surfaces as a normal dead-letter failure, instead of the
+	// fetch being silently skipped.
+	metadataFetcher := &streamingmeta.Fetcher{
+		Store: database,
+		Clients: map[string]streamingmeta.Client{
+			models.StreamingProviderSpotify:    &streamingmeta.UnconfiguredClient{Provider: models.StreamingProviderSpotify},
+			models.StreamingProviderAppleMusic: &streamingmeta.UnconfiguredClient{Provider: models.StreamingProviderAppleMusic},
+			models.StreamingProviderBandcamp:   &streamingmeta.UnconfiguredClient{Provider: models.StreamingProviderBandcamp},
+		},
+	}
+	metadataPool := &jobs.Pool{
+		Store:       database,
+		Handlers:    map[string]jobs.Handler{db.JobKindStreamingMetadataFetch: metadataFetcher.Handle},
+		WorkerID:    "api",
+		Concurrency: 1,
+	}
+	go metadataPool.Run(context.Background())
+
+	if cfg.YouTube.APIKey == "" {
+		log.Printf("api: youtube.api_key not set, skipping video metadata enrichment")
+	} else {
+		videoFetcher := &youtube.Fetcher{
+			Store:  database,
+			Client: &youtube.APIClient{APIKey: cfg.YouTube.APIKey},
+		}
+		videoPool := &jobs.Pool{
+			Store:       database,
+			Handlers:    map[string]jobs.Handler{db.JobKindVideoMetadataFetch: videoFetcher.Handle},
+			WorkerID:    "api",
+			Concurrency: 1,
+		}
+		go videoPool.Run(context.Background())
+	}
+
+	if cfg.Newsletter.APIKey == "" {
+		log.Printf("api: newsletter.api_key not set, skipping newsletter sync")
+	} else {
+		newsletterSyncer := &newsletter.Syncer{
+			Store:  database,
+			Client: &newsletter.APIClient{APIKey: cfg.Newsletter.APIKey},
+		}
+		newsletterPool := &jobs.Pool{
+			Store: database,
+			Handlers: map[string]jobs.Handler{
+				db.JobKindNewsletterSubscriberSync: newsletterSyncer.HandleSubscriberSync,
+				db.JobKindNewsletterDraftCampaign:  newsletterSyncer.HandleDraftCampaign,
+			},
+			WorkerID:    "api",
+			Concurrency: 1,
+		}
+		go newsletterPool.Run(context.Background())
+	}
+
+	mux := http.NewServeMux()
+	if cfg.Server.EnablePprof {
+		registerDebugHandlers(mux, store)
+	}
+	mux.HandleFunc("/status", handleStatus)
+	mux.HandleFunc("/performances", handlePerformances(database))
+	mux.HandleFunc("/stats/performances", handlePerformanceStats(database))
+	mux.HandleFunc("/biography", handleBiography)
+	mux.HandleFunc("/admin/config/reload", handleConfigReload(store))
+	mux.HandleFunc("/admin/config", handleConfigShow(store))
+	mux.HandleFunc("/admin/db/stats", handleDBStats(database, store))
+	actorLimiter := ratelimit.New()
+	mux.HandleFunc("/admin/export", handleExport(database, store, actorLimiter))
+	mux.HandleFunc("/admin/import", handleImport(database, store, actorLimiter))
+	mux.HandleFunc("/admin/jobs/", handleJobs(database, store, actorLimiter))
+	mux.Handle("/events/stream", hub)
+
+	imageStore, err := storage.New(storage.Config{
+		Kind:           cfg.Storage.Kind,
+		LocalDir:       cfg.Storage.LocalDir,
+		LocalPublicURL: cfg.Storage.LocalPublicURL,
+	})
+	if err != nil {
+		log.Fatalf("api: build image store: %v", err)
+	}
+	if cfg.Storage.Kind == "" || cfg.Storage.Kind == storage.KindLocal {
+		if publicURL, err := url.Parse(cfg.Storage.LocalPublicURL); err == nil && publicURL.Path != "" {
+			prefix := strings.TrimSuffix(publicURL.Path, "/") + "/"
+			mux.Handle(prefix, http.StripPrefix(prefix, http.FileServer(http.Dir(cfg.Storage.LocalDir))))
+		}
+	}
+
+	(&api.EventHandler{Store: database, VenueStore: database, ProgrammeStore: database, ImageStore: imageStore}).Register(mux)
+	(&api.WebhookChannelHandler{Store: database, Sender: webhookPoster.Sender}).Register(mux)
+	(&api.EventFeedHandler{Store: database, VenueStore: database, ArtistName: cfg.EventFeed.ArtistName}).Register(mux)
+
+	addr := fmt.Sprintf(":%d", cfg.Server.Port)
+	log.Printf("api: listening on %s", addr)
+	if err := http.ListenAndServe(addr, recoveryMiddleware(reporter, actorMiddleware(debugLogMiddleware(store, cacheControlMiddleware(store, contentTypeMiddleware(mux)))))); err != nil {
+		log.Fatalf("api: %v", err)
+	}
+}
+
+// recoveryMiddleware recovers a panicking handler, reports it and responds
+// 500 instead of letting net/http tear down the connection with no
+// response at all.
+func recoveryMiddleware(reporter errreport.Reporter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if p := recover(); p != nil {
+				err := fmt.Errorf("panic: %v", p)
+				reporter.Report(r.Context(), err)
+				log.Printf("api: recovered panic: %v", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+			}
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// actorMiddleware stashes the caller's identity in the request context so
+// mutating handlers can attribute created_by/updated_by. Until a real
+// session subsystem exists, the identity is just the X-Actor header.
+func actorMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := r.Header.Get("X-Actor")
+		if actor == "" {
+			actor = "unknown"
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithActor(r.Context(), actor)))
+	})
+}
+
+// cacheControlMiddleware sets Cache-Control and Surrogate-Control per route
+// class, so a CDN can be put in front of the API safely: the public
+// listing endpoints get their configured TTL, everything else (admin,
+// debug, the mutable /events API) gets no-store. Reads the config from
+// store on every request so cache.* changes take effect on the next
+// SIGHUP without a restart.
+func cacheControlMiddleware(store *config.Store, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cache := store.Get().Cache
+		switch r.URL.Path {
+		case "/performances":
+			setCacheControl(w, cache.PerformancesTTLSeconds)
+		case "/stats/performances":
+			setCacheControl(w, cache.PerformanceStatsTTLSeconds)
+		case "/biography":
+			setCacheControl(w, cache.BiographyTTLSeconds)
+		default:
+			w.Header().Set("Cache-Control", "no-store")
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// setCacheControl sets a public, max-age Cache-Control (and matching
+// Surrogate-Control, for CDNs that key off it separately) for ttlSeconds,
+// or falls back to no-store if caching isn't configured for the route.
+func setCacheControl(w http.ResponseWriter, ttlSeconds int) {
+	if ttlSeconds <= 0 {
+		w.Header().Set("Cache-Control", "no-store")
+		return
+	}
+	directive := fmt.Sprintf("public, max-age=%d", ttlSeconds)
+	w.Header().Set("Cache-Control", directive)
+	w.Header().Set("Surrogate-Control", directive)
+}
+
+// contentTypeMiddleware rejects a POST/PUT/PATCH request that carries a
+// body but not a Content-Type of application/json with 415 and a
+// structured error, instead of letting it reach a handler's json.Decode
+// and fail there with a confusing "invalid character" parse error. The
+// one exception is POST /events/{id}/image, the sole multipart endpoint
+// in this API, which is left to enforce its own Content-Type. A request
+// with no body (an empty POST like /admin/config/reload) is exempt too,
+// since there's nothing to have a media type.
+func contentTypeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch:
+			if r.ContentLength == 0 {
+				break
+			}
+			if strings.HasSuffix(r.URL.Path, "/image") {
+				break
+			}
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "application/json" {
+				api.WriteErrorResponse(w, http.StatusUnsupportedMediaType, api.CodeUnsupportedMediaType, "Content-Type must be application/json")
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// debugLogMiddleware flags a single request's context for elevated debug
+// logging, including db query logging, when an authenticated admin sends
+// an X-Debug-Log header or the request comes from a trusted IP listed in
+// debug_log.allowed_ips. It never enables debug logging globally, so it's
+// safe to leave configured in production.
+func debugLogMiddleware(store *config.Store, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := store.Get()
+		if (r.Header.Get("X-Debug-Log") != "" && isAdminRequest(r, cfg)) || isDebugLogIP(r, cfg) {
+			r = r.WithContext(db.WithDebugLog(r.Context()))
+			log.Printf("api: debug logging enabled for %s %s", r.Method, r.URL.Path)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// isDebugLogIP reports whether r's remote address is in cfg's
+// debug_log.allowed_ips list.
+func isDebugLogIP(r *http.Request, cfg *config.Config) bool {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	for _, ip := range cfg.DebugLog.AllowedIPs {
+		if ip == host {
+			return true
+		}
+	}
+	return false
+}
+
+// sendEventReminders emails cfg.AdminEmail a digest of events happening
+// within leadTime that are still drafts or missing a venue or ticket
+// link, rendered from tmpl. It's a no-op if there's nothing to report.
+func sendEventReminders(ctx context.Context, database *db.DB, sender mailer.Sender, tmpl *mailer.Template, cfg config.EventReminderConfig, leadTime time.Duration) error {
+	events, err := database.ListIncompleteUpcomingEvents(ctx, leadTime)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]eventDigestRow, len(events))
+	for i, event := range events {
+		rows[i] = eventDigestRow{
+			Title:     event.Title,
+			EventDate: event.EventDate.Time().Format("2006-01-02"),
+			Issues:    eventIssues(event),
+		}
+	}
+
+	msg, err := tmpl.Render([]string{cfg.AdminEmail}, struct {
+		LeadDays int
+		Events   []eventDigestRow
+	}{LeadDays: cfg.LeadDays, Events: rows})
+	if err != nil {
+		return err
+	}
+	if err := sender.Send(ctx, msg); err != nil {
+		return err
+	}
+	log.Printf("api: sent event reminder digest for %d event(s)", len(events))
+	return nil
+}
+
+// eventDigestRow is one line of the reminder digest email.
+type eventDigestRow struct {
+	Title     string
+	EventDate string
+	Issues    string
+}
+
+// eventIssues describes, in a comma-separated phrase, why event was
+// flagged for the reminder digest.
+func eventIssues(event models.Event) string {
+	var issues []string
+	if event.Status == models.StatusDraft {
+		issues = append(issues, "still a draft")
+	}
+	if event.VenueID == nil {
+		issues = append(issues, "no venue")
+	}
+	if len(event.TicketOffers) == 0 {
+		issues = append(issues, "no ticket offers")
+	}
+	return strings.Join(issues, ", ")
+}
+
+// watchChannel forwards every Postgres notification on channel to hub as an
+// SSE event of the same name, so connected admin/public clients invalidate
+// their caches as soon as a mutation commits instead of waiting on a TTL.
+func watchChannel(database *db.DB, hub *api.Hub, channel string) {
+	notifications, err := database.Listen(context.Background(), channel)
+	if err != nil {
+		log.Printf("api: listen %s: %v", channel, err)
+		return
+	}
+	go func() {
+		for payload := range notifications {
+			hub.Broadcast(channel, payload)
+		}
+	}()
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+)
+
+func runData(args []string) {
+	if len(args) < 1 {
+		usage()
+		os.Exit(2)
+	}
+	switch args[0] {
+	case "export":
+		dataExport(args[1:])
+	case "import":
+		dataImport(args[1:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func dataExport(args []string) {
+	fs := flag.NewFlagSet("data export", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	out := fs.String("out", "", "path to write the JSON archive to")
+	fs.Parse(args)
+
+	if *out == "" {
+		log.Fatal("ntumiwactl: data export: -out is required")
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	archive, err := database.Export(context.Background())
+	if err != nil {
+		log.Fatalf("ntumiwactl: data export: %v", err)
+	}
+	b, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		log.Fatalf("ntumiwactl: data export: marshal archive: %v", err)
+	}
+	if err := os.WriteFile(*out, b, 0o644); err != nil {
+		log.Fatalf("ntumiwactl: data export: write %s: %v", *out, err)
+	}
+	fmt.Printf("exported catalogue to %s\n", *out)
+}
+
+// dataImport restores a JSON archive previously produced by "data export".
+// By default it upserts by the archive's original ids, which is what a
+// staging refresh from the same environment wants. -remap-ids instead
+// assigns every row a fresh id, rewriting foreign keys as it goes, for
+// importing into an environment with its own overlapping ids, such as a
+// migration from the artist's previous website.
+func dataImport(args []string) {
+	fs := flag.NewFlagSet("data import", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	in := fs.String("in", "", "path to a JSON archive to read")
+	remapIDs := fs.Bool("remap-ids", false, "assign fresh ids instead of reusing the archive's own")
+	fs.Parse(args)
+
+	if *in == "" {
+		log.Fatal("ntumiwactl: data import: -in is required")
+	}
+
+	b, err := os.ReadFile(*in)
+	if err != nil {
+		log.Fatalf("ntumiwactl: data import: read %s: %v", *in, err)
+	}
+	var archive db.Archive
+	if err := json.Unmarshal(b, &archive); err != nil {
+		log.Fatalf("ntumiwactl: data import: parse %s: %v", *in, err)
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	if *remapIDs {
+		if err := database.ImportRemapped(context.Background(), &archive); err != nil {
+			log.Fatalf("ntumiwactl: data import: %v", err)
+		}
+	} else {
+		if err := database.Import(context.Background(), &archive); err != nil {
+			log.Fatalf("ntumiwactl: data import: %v", err)
+		}
+	}
+	fmt.Printf("imported catalogue from %s\n", *in)
+}
@@ -0,0 +1,142 @@
+// Command ntumiwactl is a maintenance CLI for admin accounts, migrations,
+// data export/import and profiles, talking to Postgres directly through the db package rather than an
+// HTTP API — there's no api process to talk to yet when its first job,
+// bootstrapping the initial admin account, needs doing.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/config"
+	"github.com/adamkadda/ntumiwa/internal/db"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "user":
+		runUser(os.Args[2:])
+	case "migrate":
+		runMigrate(os.Args[2:])
+	case "data":
+		runData(os.Args[2:])
+	case "profile":
+		runProfile(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: ntumiwactl user <create|set-password|deactivate> [flags]")
+	fmt.Fprintln(os.Stderr, "       ntumiwactl migrate <up|down|status|new> [flags]")
+	fmt.Fprintln(os.Stderr, "       ntumiwactl data <export|import> [flags]")
+	fmt.Fprintln(os.Stderr, "       ntumiwactl profile <create|list> [flags]")
+}
+
+func runUser(args []string) {
+	if len(args) < 1 {
+		usage()
+		os.Exit(2)
+	}
+	switch args[0] {
+	case "create":
+		userCreate(args[1:])
+	case "set-password":
+		userSetPassword(args[1:])
+	case "deactivate":
+		userDeactivate(args[1:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+// openDB connects to Postgres using the same config the api binary loads,
+// since ntumiwactl is meant to be run against the same database.
+func openDB(configPath string) *db.DB {
+	cfg, err := config.LoadConfig(config.KindAPI, configPath)
+	if err != nil {
+		log.Fatalf("ntumiwactl: load config: %v", err)
+	}
+	timeouts := db.Timeouts{
+		Read:   time.Duration(cfg.Postgres.Timeouts.ReadSeconds) * time.Second,
+		Write:  time.Duration(cfg.Postgres.Timeouts.WriteSeconds) * time.Second,
+		Report: time.Duration(cfg.Postgres.Timeouts.ReportSeconds) * time.Second,
+	}
+	database, err := db.Open(context.Background(), cfg.Postgres.DSN(), cfg.Postgres.ReadReplicaDSN(), timeouts)
+	if err != nil {
+		log.Fatalf("ntumiwactl: connect to postgres: %v", err)
+	}
+	return database
+}
+
+func userCreate(args []string) {
+	fs := flag.NewFlagSet("user create", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	email := fs.String("email", "", "the new admin's email address")
+	password := fs.String("password", "", "the new admin's initial password")
+	fs.Parse(args)
+
+	if *email == "" || *password == "" {
+		log.Fatal("ntumiwactl: user create: -email and -password are required")
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	user, err := database.CreateAdminUser(context.Background(), *email, *password)
+	if err != nil {
+		log.Fatalf("ntumiwactl: user create: %v", err)
+	}
+	fmt.Printf("created admin user %d <%s>\n", user.ID, user.Email)
+}
+
+func userSetPassword(args []string) {
+	fs := flag.NewFlagSet("user set-password", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	email := fs.String("email", "", "the admin's email address")
+	password := fs.String("password", "", "the new password")
+	fs.Parse(args)
+
+	if *email == "" || *password == "" {
+		log.Fatal("ntumiwactl: user set-password: -email and -password are required")
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	if _, err := database.SetAdminUserPassword(context.Background(), *email, *password); err != nil {
+		log.Fatalf("ntumiwactl: user set-password: %v", err)
+	}
+	fmt.Printf("password updated for %s\n", *email)
+}
+
+func userDeactivate(args []string) {
+	fs := flag.NewFlagSet("user deactivate", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	email := fs.String("email", "", "the admin's email address")
+	fs.Parse(args)
+
+	if *email == "" {
+		log.Fatal("ntumiwactl: user deactivate: -email is required")
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	if _, err := database.SetAdminUserActive(context.Background(), *email, false); err != nil {
+		log.Fatalf("ntumiwactl: user deactivate: %v", err)
+	}
+	fmt.Printf("deactivated %s\n", *email)
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const defaultMigrationsDir = "internal/db/migrations"
+
+func runMigrate(args []string) {
+	if len(args) < 1 {
+		usage()
+		os.Exit(2)
+	}
+	switch args[0] {
+	case "up":
+		migrateUp(args[1:])
+	case "down":
+		migrateDown(args[1:])
+	case "status":
+		migrateStatus(args[1:])
+	case "new":
+		migrateNew(args[1:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func migrateUp(args []string) {
+	fs := flag.NewFlagSet("migrate up", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	dryRun := fs.Bool("dry-run", false, "print the SQL that would run instead of applying it")
+	fs.Parse(args)
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	ctx := context.Background()
+	if *dryRun {
+		pending, err := database.PendingUp(ctx)
+		if err != nil {
+			log.Fatalf("ntumiwactl: migrate up: %v", err)
+		}
+		if len(pending) == 0 {
+			fmt.Println("-- up to date, nothing to apply")
+			return
+		}
+		for _, m := range pending {
+			fmt.Printf("-- %04d_%s.up.sql\n%s\n", m.Version, m.Name, m.Up)
+		}
+		return
+	}
+
+	if err := database.Migrate(ctx); err != nil {
+		log.Fatalf("ntumiwactl: migrate up: %v", err)
+	}
+	fmt.Println("migrated up")
+}
+
+func migrateDown(args []string) {
+	fs := flag.NewFlagSet("migrate down", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	dryRun := fs.Bool("dry-run", false, "print the SQL that would run instead of applying it")
+	fs.Parse(args)
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	ctx := context.Background()
+	if *dryRun {
+		m, err := database.PendingDown(ctx)
+		if err != nil {
+			log.Fatalf("ntumiwactl: migrate down: %v", err)
+		}
+		if m == nil {
+			fmt.Println("-- nothing applied, nothing to roll back")
+			return
+		}
+		fmt.Printf("-- %04d_%s.down.sql\n%s\n", m.Version, m.Name, m.Down)
+		return
+	}
+
+	if err := database.MigrateDown(ctx); err != nil {
+		log.Fatalf("ntumiwactl: migrate down: %v", err)
+	}
+	fmt.Println("migrated down")
+}
+
+func migrateStatus(args []string) {
+	fs := flag.NewFlagSet("migrate status", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	fs.Parse(args)
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	statuses, err := database.Status(context.Background())
+	if err != nil {
+		log.Fatalf("ntumiwactl: migrate status: %v", err)
+	}
+	for _, s := range statuses {
+		state := "pending"
+		if s.Applied {
+			state = "applied"
+		}
+		fmt.Printf("%04d_%s: %s\n", s.Version, s.Name, state)
+	}
+}
+
+// migrateNew scaffolds an empty up/down SQL file pair for the next
+// migration version, so an editor can fill them in. It never touches the
+// database — the version comes from the highest one already on disk.
+func migrateNew(args []string) {
+	fs := flag.NewFlagSet("migrate new", flag.ExitOnError)
+	dir := fs.String("dir", defaultMigrationsDir, "directory holding migration .sql files")
+	fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		log.Fatal("ntumiwactl: migrate new: exactly one migration name is required")
+	}
+	name := fs.Arg(0)
+
+	next, err := nextMigrationVersion(*dir)
+	if err != nil {
+		log.Fatalf("ntumiwactl: migrate new: %v", err)
+	}
+
+	base := fmt.Sprintf("%04d_%s", next, name)
+	up := filepath.Join(*dir, base+".up.sql")
+	down := filepath.Join(*dir, base+".down.sql")
+
+	for _, path := range []string{up, down} {
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			log.Fatalf("ntumiwactl: migrate new: write %s: %v", path, err)
+		}
+	}
+	fmt.Println(up)
+	fmt.Println(down)
+}
+
+// nextMigrationVersion returns one past the highest version number found
+// among dir's *.up.sql/*.down.sql files, or 1 if dir has none.
+func nextMigrationVersion(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("read %s: %w", dir, err)
+	}
+
+	highest := 0
+	for _, e := range entries {
+		underscore := strings.IndexByte(e.Name(), '_')
+		if underscore < 0 {
+			continue
+		}
+		v, err := strconv.Atoi(e.Name()[:underscore])
+		if err != nil {
+			continue
+		}
+		if v > highest {
+			highest = v
+		}
+	}
+	return highest + 1, nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func runProfile(args []string) {
+	if len(args) < 1 {
+		usage()
+		os.Exit(2)
+	}
+	switch args[0] {
+	case "create":
+		profileCreate(args[1:])
+	case "list":
+		profileList(args[1:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+}
+
+func profileCreate(args []string) {
+	fs := flag.NewFlagSet("profile create", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	slug := fs.String("slug", "", "the profile's unique slug")
+	name := fs.String("name", "", "the profile's display name")
+	fs.Parse(args)
+
+	if *slug == "" || *name == "" {
+		log.Fatal("ntumiwactl: profile create: -slug and -name are required")
+	}
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	profile, err := database.CreateProfile(context.Background(), *slug, *name)
+	if err != nil {
+		log.Fatalf("ntumiwactl: profile create: %v", err)
+	}
+	fmt.Printf("created profile %d %s (%s)\n", profile.ID, profile.Name, profile.Slug)
+}
+
+func profileList(args []string) {
+	fs := flag.NewFlagSet("profile list", flag.ExitOnError)
+	configPath := fs.String("config", "", "path to a YAML config file (overrides CONFIG_FILE)")
+	fs.Parse(args)
+
+	database := openDB(*configPath)
+	defer database.Close()
+
+	profiles, err := database.ListProfiles(context.Background())
+	if err != nil {
+		log.Fatalf("ntumiwactl: profile list: %v", err)
+	}
+	for _, p := range profiles {
+		fmt.Printf("%d\t%s\t%s\n", p.ID, p.Slug, p.Name)
+	}
+}
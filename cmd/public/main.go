@@ -0,0 +1,76 @@
+// Command public serves Nadia Tumiwa's public-facing website: the static
+// pages plus embedded assets, backed by reads from the API.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/adamkadda/ntumiwa/internal/apiclient"
+	"github.com/adamkadda/ntumiwa/internal/assets"
+	"github.com/adamkadda/ntumiwa/internal/buildinfo"
+	"github.com/adamkadda/ntumiwa/internal/config"
+	"github.com/adamkadda/ntumiwa/internal/logging"
+)
+
+func main() {
+	var (
+		port     = flag.Int("port", 0, "port to listen on (overrides env)")
+		logLevel = flag.String("log-level", "", "log level: debug, info, warn, error")
+		version  = flag.Bool("version", false, "print version information and exit")
+		printCfg = flag.Bool("print-config", false, "print the effective config, with secrets redacted, and exit")
+	)
+	flag.Parse()
+
+	if *version {
+		fmt.Println(buildinfo.String("public"))
+		os.Exit(0)
+	}
+
+	cfg, err := config.LoadConfig(config.KindPublic, "")
+	if err != nil {
+		log.Fatalf("public: load config: %v", err)
+	}
+	if *port != 0 {
+		cfg.Frontend.Port = *port
+	}
+	if *logLevel != "" {
+		cfg.Log.Level = *logLevel
+	}
+	if err := logging.Setup(logging.Config{
+		Output:       cfg.Log.Output,
+		FilePath:     cfg.Log.FilePath,
+		MaxSizeMB:    cfg.Log.MaxSizeMB,
+		MaxBackups:   cfg.Log.MaxBackups,
+		SyslogAddr:   cfg.Log.SyslogAddr,
+		OTLPEndpoint: cfg.Log.OTLPEndpoint,
+	}); err != nil {
+		log.Fatalf("public: set up logging: %v", err)
+	}
+
+	if *printCfg {
+		b, err := json.MarshalIndent(cfg.Redact(), "", "  ")
+		if err != nil {
+			log.Fatalf("public: marshal config: %v", err)
+		}
+		fmt.Println(string(b))
+		os.Exit(0)
+	}
+
+	client := apiclient.New(apiclient.Config{BaseURL: cfg.Frontend.APIBaseURL})
+	_ = client // wired into page handlers as they're templated
+
+	mux := http.NewServeMux()
+	mux.Handle("/static/", assets.Handler("/static/"))
+	mux.Handle("/", http.FileServer(http.Dir("pages")))
+
+	addr := fmt.Sprintf(":%d", cfg.Frontend.Port)
+	log.Printf("public: listening on %s", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		log.Fatalf("public: %v", err)
+	}
+}
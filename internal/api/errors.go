@@ -0,0 +1,109 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/adamkadda/ntumiwa/internal/errreport"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// statusClientClosedRequest mirrors nginx's 499: the client gave up before
+// the server finished, distinct from a server-side timeout.
+const statusClientClosedRequest = 499
+
+// ErrorCode is a stable identifier for a class of API error, returned in
+// the error envelope alongside the human-readable message so a frontend
+// can branch on it instead of string-matching the message.
+type ErrorCode string
+
+const (
+	CodeRequestFailed        ErrorCode = "request_failed"
+	CodeDeadlineExceeded     ErrorCode = "deadline_exceeded"
+	CodeClientClosedRequest  ErrorCode = "client_closed_request"
+	CodeNotFound             ErrorCode = "not_found"
+	CodeValidationFailed     ErrorCode = "validation_failed"
+	CodeUniqueViolation      ErrorCode = "unique_violation"
+	CodeFKViolation          ErrorCode = "fk_violation"
+	CodeUnsupportedMediaType ErrorCode = "unsupported_media_type"
+)
+
+// postgresErrorCodes maps the Postgres error codes writeError knows how to
+// classify to their ErrorCode, so a new one is added in one place.
+var postgresErrorCodes = map[string]ErrorCode{
+	"23505": CodeUniqueViolation, // unique_violation
+	"23503": CodeFKViolation,     // foreign_key_violation
+}
+
+// postgresErrorStatus is the HTTP status that goes with each entry in
+// postgresErrorCodes.
+var postgresErrorStatus = map[ErrorCode]int{
+	CodeUniqueViolation: http.StatusConflict,
+	CodeFKViolation:     http.StatusBadRequest,
+}
+
+// reporter receives every 5xx error writeError surfaces, so a handler
+// failure isn't only visible in stdout logs. It defaults to a no-op;
+// SetReporter installs the real one once, at startup.
+var reporter errreport.Reporter = errreport.NoopReporter{}
+
+// SetReporter installs the Reporter writeError reports 5xx errors to.
+func SetReporter(r errreport.Reporter) {
+	reporter = r
+}
+
+type errorResponse struct {
+	Error string    `json:"error"`
+	Code  ErrorCode `json:"code"`
+}
+
+// writeError inspects err for a context deadline or cancellation and maps it
+// to the appropriate status/code; anything else falls back to the status a
+// handler would have used before this distinction existed. 5xx errors are
+// also sent to the configured Reporter.
+func writeError(ctx context.Context, w http.ResponseWriter, err error, fallback int) {
+	code, status := classifyError(err, fallback)
+	if status >= http.StatusInternalServerError {
+		reporter.Report(ctx, err)
+	}
+	WriteErrorResponse(w, status, code, err.Error())
+}
+
+// WriteErrorResponse writes the same JSON error envelope writeError does,
+// for callers outside package api (e.g. cmd/api middleware) that already
+// know the status and code they want and have nothing to classify or
+// report.
+func WriteErrorResponse(w http.ResponseWriter, status int, code ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: message, Code: code})
+}
+
+// classifyError maps err to the ErrorCode/status pair that best describes
+// it, falling back to CodeRequestFailed/fallback for anything it doesn't
+// recognize as one of the db layer's sentinel conditions.
+func classifyError(err error, fallback int) (code ErrorCode, status int) {
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return CodeDeadlineExceeded, http.StatusGatewayTimeout
+	case errors.Is(err, context.Canceled):
+		return CodeClientClosedRequest, statusClientClosedRequest
+	case errors.Is(err, pgx.ErrNoRows):
+		return CodeNotFound, http.StatusNotFound
+	case errors.As(err, &validate.Errors{}):
+		return CodeValidationFailed, http.StatusBadRequest
+	case errors.As(err, &pgErr):
+		if code, ok := postgresErrorCodes[pgErr.Code]; ok {
+			return code, postgresErrorStatus[code]
+		}
+		return CodeRequestFailed, fallback
+	default:
+		return CodeRequestFailed, fallback
+	}
+}
@@ -0,0 +1,531 @@
+// Package api holds the HTTP handlers for the ntumiwa API, decoupled from
+// cmd/api's process wiring so they can be tested against fake stores.
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/httpjson"
+	"github.com/adamkadda/ntumiwa/internal/markdown"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/queryparam"
+	"github.com/adamkadda/ntumiwa/internal/storage"
+)
+
+// EventHandler serves the /events routes against an EventStore, so tests
+// can substitute a fake without a real database. VenueStore and
+// ProgrammeStore are only needed to hydrate ?expand=venue,programme; a
+// handler built without them just leaves those relations as bare IDs.
+// ImageStore is only needed for the poster upload endpoint; a handler
+// built without it responds 503 to an upload rather than panicking.
+type EventHandler struct {
+	Store          db.EventStore
+	VenueStore     db.VenueStore
+	ProgrammeStore db.ProgrammeStore
+	ImageStore     storage.Store
+}
+
+func (h *EventHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/events", h.collection)
+	mux.HandleFunc("/events/trash", h.trash)
+	mux.HandleFunc("/events/stats", h.stats)
+	mux.HandleFunc("/events/", h.item)
+}
+
+func (h *EventHandler) collection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *EventHandler) item(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/events/")
+	idStr, action, hasAction := strings.Cut(rest, "/")
+
+	if hasAction {
+		id, ok := parseEventID(w, idStr)
+		if !ok {
+			return
+		}
+		if action == "restore" && r.Method == http.MethodPost {
+			h.restore(w, r, id)
+			return
+		}
+		if action == "debrief" {
+			h.debrief(w, r, id)
+			return
+		}
+		if action == "roll-forward" && r.Method == http.MethodPost {
+			h.rollForward(w, r, id)
+			return
+		}
+		if action == "duplicate" && r.Method == http.MethodPost {
+			h.duplicate(w, r, id)
+			return
+		}
+		if action == "history" && r.Method == http.MethodGet {
+			h.history(w, r, id)
+			return
+		}
+		if action == "ics" && r.Method == http.MethodGet {
+			h.ics(w, r, id)
+			return
+		}
+		if action == "image" && r.Method == http.MethodPost {
+			h.image(w, r, id)
+			return
+		}
+		if action == "status" && r.Method == http.MethodPatch {
+			h.status(w, r, id)
+			return
+		}
+		if action == "revisions" && r.Method == http.MethodGet {
+			h.revisions(w, r, id)
+			return
+		}
+		if rev, ok := strings.CutPrefix(action, "revisions/"); ok {
+			if revStr, sub, hasSub := strings.Cut(rev, "/"); hasSub && sub == "rollback" && r.Method == http.MethodPost {
+				h.rollback(w, r, id, revStr)
+				return
+			}
+		}
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.get(w, r, idStr)
+	case http.MethodPatch, http.MethodPut:
+		if id, ok := parseEventID(w, idStr); ok {
+			h.update(w, r, id)
+		}
+	case http.MethodDelete:
+		if id, ok := parseEventID(w, idStr); ok {
+			h.delete(w, r, id)
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// parseEventID parses idStr as a numeric event id, writing a 400 and
+// reporting failure if it isn't one. Kept separate from get's id-or-slug
+// resolution because create/update/delete/restore always act on the
+// canonical numeric id.
+func parseEventID(w http.ResponseWriter, idStr string) (int64, bool) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid event id", http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+// eventListResponse is the JSON envelope returned by GET /events: the
+// page of matching events plus the total count across every page, so a
+// client (the admin UI's event table, say) can page through hundreds of
+// events without fetching them all at once.
+type eventListResponse struct {
+	Events []json.RawMessage `json:"events"`
+	Total  int               `json:"total"`
+	Limit  int               `json:"limit"`
+	Offset int               `json:"offset"`
+}
+
+// eventSortAliases maps the short-form ?sort= tokens the admin UI has
+// historically sent (e.g. "date_desc") onto the {field}[,-{field}]
+// grammar queryparam.ParseSort understands, so either style resolves to
+// the same SortKey before it reaches the EventSortFields whitelist.
+var eventSortAliases = map[string]queryparam.SortKey{
+	"date_asc":     {Field: "event_date"},
+	"date_desc":    {Field: "event_date", Desc: true},
+	"updated_asc":  {Field: "updated_at"},
+	"updated_desc": {Field: "updated_at", Desc: true},
+	"title_asc":    {Field: "title"},
+	"title_desc":   {Field: "title", Desc: true},
+}
+
+// resolveEventSortAliases rewrites any key whose field is a recognized
+// alias in place, leaving keys that already name a real column (e.g.
+// "title", "-created_at") untouched.
+func resolveEventSortAliases(keys []queryparam.SortKey) []queryparam.SortKey {
+	resolved := make([]queryparam.SortKey, len(keys))
+	for i, k := range keys {
+		if alias, ok := eventSortAliases[k.Field]; ok {
+			k = alias
+		}
+		resolved[i] = k
+	}
+	return resolved
+}
+
+func (h *EventHandler) list(w http.ResponseWriter, r *http.Request) {
+	sortKeys := resolveEventSortAliases(queryparam.ParseSort(r.URL.Query().Get("sort")))
+	if err := queryparam.Whitelist(sortKeys, db.EventSortFields); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	filters, err := queryparam.ParseFilters(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	page, err := queryparam.ParsePage(r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	deleted := r.URL.Query().Get("deleted") == "true"
+	filter := db.EventFilter{Filters: filters, Sort: sortKeys, Deleted: deleted, Limit: page.Limit, Offset: page.Offset}
+	events, total, err := h.Store.ListEvents(r.Context(), filter)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	selected, err := httpjson.SelectedFields(withLinks(events, deleted), httpjson.Fields(r))
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, eventListResponse{Events: selected, Total: total, Limit: page.Limit, Offset: page.Offset})
+}
+
+func (h *EventHandler) get(w http.ResponseWriter, r *http.Request, idOrSlug string) {
+	event, err := lookupEvent(r.Context(), h.Store, idOrSlug)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) && !isNumeric(idOrSlug) {
+			if current, redirectErr := h.Store.ResolveEventSlugRedirect(r.Context(), idOrSlug); redirectErr == nil {
+				http.Redirect(w, r, withCanonicalSlug(r.URL, current.Slug), http.StatusMovedPermanently)
+				return
+			}
+		}
+		writeError(r.Context(), w, err, http.StatusNotFound)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, h.expand(r, event))
+}
+
+// isNumeric reports whether s parses as the numeric event id lookupEvent
+// would try first, so the slug-redirect fallback isn't attempted for an
+// id that's simply wrong.
+func isNumeric(s string) bool {
+	_, err := strconv.ParseInt(s, 10, 64)
+	return err == nil
+}
+
+// withCanonicalSlug rewrites old's last path segment to slug, keeping the
+// /events/ prefix and any query string (e.g. ?expand=venue) intact.
+func withCanonicalSlug(old *url.URL, slug string) string {
+	u := *old
+	u.Path = "/events/" + slug
+	return u.String()
+}
+
+// lookupEvent resolves idOrSlug against store: a numeric segment is
+// looked up by id, anything else by slug, so a single path segment can
+// serve both without breaking clients still using numeric ids.
+func lookupEvent(ctx context.Context, store db.EventStore, idOrSlug string) (*models.Event, error) {
+	if id, err := strconv.ParseInt(idOrSlug, 10, 64); err == nil {
+		return store.GetEvent(ctx, id)
+	}
+	return store.GetEventBySlug(ctx, idOrSlug)
+}
+
+// eventResponse is the JSON shape returned by GET /events/{id}: event as
+// usual, plus venue and/or programme hydrated in place of their bare IDs,
+// and the debrief and/or calendar hold, when named in ?expand=.
+type eventResponse struct {
+	*models.Event
+	NotesHTML    string               `json:"notes_html,omitempty"`
+	Venue        *models.Venue        `json:"venue,omitempty"`
+	Programme    *models.Programme    `json:"programme,omitempty"`
+	Debrief      *models.EventDebrief `json:"debrief,omitempty"`
+	CalendarHold *models.CalendarHold `json:"calendar_hold,omitempty"`
+	Links        map[string]string    `json:"_links"`
+}
+
+// eventListItem is the JSON shape returned for each row of GET /events and
+// GET /events/trash: event plus its _links, without the expand/hydration
+// eventResponse carries for the single-item response.
+type eventListItem struct {
+	*models.Event
+	NotesHTML string            `json:"notes_html,omitempty"`
+	Links     map[string]string `json:"_links"`
+}
+
+// withLinks wraps each event with its _links section for a list response.
+func withLinks(events []models.Event, trashed bool) []eventListItem {
+	items := make([]eventListItem, len(events))
+	for i := range events {
+		items[i] = eventListItem{Event: &events[i], NotesHTML: markdown.ToHTML(events[i].Notes), Links: eventLinks(&events[i], trashed)}
+	}
+	return items
+}
+
+// expand hydrates the relations named in ?expand=venue,programme onto
+// event's response, so a request that doesn't ask for them skips the
+// extra lookups entirely. A relation that fails to load or has no store
+// wired up is left as its bare ID rather than failing the whole request.
+func (h *EventHandler) expand(r *http.Request, event *models.Event) any {
+	resp := eventResponse{Event: event, NotesHTML: markdown.ToHTML(event.Notes), Links: eventLinks(event, false)}
+	raw := r.URL.Query().Get("expand")
+	if raw == "" {
+		return resp
+	}
+	for _, rel := range strings.Split(raw, ",") {
+		switch rel {
+		case "venue":
+			if event.VenueID == nil || h.VenueStore == nil {
+				continue
+			}
+			if venue, err := h.VenueStore.GetVenue(r.Context(), *event.VenueID); err == nil {
+				resp.Venue = venue
+			}
+		case "programme":
+			if event.ProgrammeID == nil || h.ProgrammeStore == nil {
+				continue
+			}
+			if programme, err := h.ProgrammeStore.GetProgramme(r.Context(), *event.ProgrammeID); err == nil {
+				resp.Programme = programme
+			}
+		case "debrief":
+			if debrief, err := h.Store.GetEventDebrief(r.Context(), event.ID); err == nil {
+				resp.Debrief = debrief
+			}
+		case "calendar_hold":
+			if hold, err := h.Store.GetCalendarHoldByEvent(r.Context(), event.ID); err == nil {
+				resp.CalendarHold = hold
+			}
+		}
+	}
+	return resp
+}
+
+func (h *EventHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req models.EventRequest
+	if err := httpjson.Decode(w, r, &req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	event, err := h.Store.CreateEvent(r.Context(), req)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusCreated, event)
+}
+
+func (h *EventHandler) update(w http.ResponseWriter, r *http.Request, id int64) {
+	var req models.EventRequest
+	if err := httpjson.Decode(w, r, &req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	event, err := h.Store.UpdateEvent(r.Context(), id, req)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, event)
+}
+
+// delete moves the event to the trash rather than removing it outright; see
+// trash and restore.
+func (h *EventHandler) delete(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.Store.DeleteEvent(r.Context(), id); err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EventHandler) trash(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	events, err := h.Store.ListTrashedEvents(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	_ = httpjson.StreamArray(w, http.StatusOK, withLinks(events, true), httpjson.Fields(r))
+}
+
+// stats serves GET /events/stats: the admin dashboard summary of counts
+// by status, how many events are upcoming, and how many fall in each of
+// the next twelve months.
+func (h *EventHandler) stats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	stats, err := h.Store.EventStats(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, stats)
+}
+
+func (h *EventHandler) restore(w http.ResponseWriter, r *http.Request, id int64) {
+	event, err := h.Store.RestoreEvent(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, event)
+}
+
+// rollForward serves POST /events/{id}/roll-forward: clone the event years
+// (default 1) ahead for the next occurrence of an annual engagement. A
+// request body is optional; an empty one just takes the default.
+func (h *EventHandler) rollForward(w http.ResponseWriter, r *http.Request, id int64) {
+	var req models.EventRollForwardRequest
+	if err := httpjson.Decode(w, r, &req); err != nil && err != io.EOF {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	years, ok := req.Years.Get()
+	if !ok {
+		years = 1
+	}
+	event, err := h.Store.RollForwardEvent(r.Context(), id, years)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusCreated, event)
+}
+
+// duplicate serves POST /events/{id}/duplicate: clone the event as a new
+// draft, optionally on a different date. A request body is optional; an
+// empty one just keeps the original's date.
+func (h *EventHandler) duplicate(w http.ResponseWriter, r *http.Request, id int64) {
+	var req models.EventDuplicateRequest
+	if err := httpjson.Decode(w, r, &req); err != nil && err != io.EOF {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	event, err := h.Store.DuplicateEvent(r.Context(), id, req)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusCreated, event)
+}
+
+// history serves GET /events/{id}/history: the audit trail of who
+// changed what on this event and when, most recent first.
+func (h *EventHandler) history(w http.ResponseWriter, r *http.Request, id int64) {
+	entries, err := h.Store.ListEventHistory(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	_ = httpjson.StreamArray(w, http.StatusOK, entries, httpjson.Fields(r))
+}
+
+// revisions serves GET /events/{id}/revisions: the full content
+// snapshots recorded before each update, most recent first, for
+// rollback to pick a revision from.
+func (h *EventHandler) revisions(w http.ResponseWriter, r *http.Request, id int64) {
+	revisions, err := h.Store.ListEventRevisions(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	_ = httpjson.StreamArray(w, http.StatusOK, revisions, httpjson.Fields(r))
+}
+
+// rollback serves POST /events/{id}/revisions/{rev}/rollback: restore
+// the event's content to what it was at that revision.
+func (h *EventHandler) rollback(w http.ResponseWriter, r *http.Request, id int64, revStr string) {
+	rev, err := strconv.Atoi(revStr)
+	if err != nil {
+		http.Error(w, "invalid revision", http.StatusBadRequest)
+		return
+	}
+	event, err := h.Store.RollbackEventRevision(r.Context(), id, rev)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, event)
+}
+
+// eventStatusRequest is the body PATCH /events/{id}/status expects: the
+// single status the caller wants to move the event to, plus a Reason
+// that's only meaningful (and only kept) when Status is StatusCancelled.
+// Whether the move is actually allowed from the event's current status
+// is decided by models.EventStatus.CanTransitionTo, not here.
+type eventStatusRequest struct {
+	Status models.EventStatus `json:"status"`
+	Reason string             `json:"reason,omitempty"`
+}
+
+// status serves PATCH /events/{id}/status: the one place an event's
+// status can be changed, replacing what would otherwise be a separate
+// draft/publish/archive endpoint per transition.
+func (h *EventHandler) status(w http.ResponseWriter, r *http.Request, id int64) {
+	var req eventStatusRequest
+	if err := httpjson.Decode(w, r, &req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	if !req.Status.Valid() {
+		http.Error(w, "invalid event status", http.StatusBadRequest)
+		return
+	}
+	event, err := h.Store.TransitionEventStatus(r.Context(), id, req.Status, req.Reason)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, event)
+}
+
+// debrief serves GET/PUT /events/{id}/debrief: the private post-event
+// record, kept off the event itself so it's never accidentally returned
+// alongside the public-facing fields.
+func (h *EventHandler) debrief(w http.ResponseWriter, r *http.Request, id int64) {
+	switch r.Method {
+	case http.MethodGet:
+		debrief, err := h.Store.GetEventDebrief(r.Context(), id)
+		if err != nil {
+			writeError(r.Context(), w, err, http.StatusNotFound)
+			return
+		}
+		httpjson.Respond(w, http.StatusOK, debrief)
+	case http.MethodPut:
+		var req models.EventDebriefRequest
+		if err := httpjson.Decode(w, r, &req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+		debrief, err := h.Store.UpsertEventDebrief(r.Context(), id, req)
+		if err != nil {
+			writeError(r.Context(), w, err, http.StatusBadRequest)
+			return
+		}
+		httpjson.Respond(w, http.StatusOK, debrief)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
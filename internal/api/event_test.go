@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/adamkadda/ntumiwa/internal/apitest"
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// fakeEventStore embeds db.EventStore so a test only has to override the
+// methods the handler under test actually calls; anything else panics if
+// exercised, which is a clear failure rather than a silent nil-pointer
+// deref on a real *db.DB.
+type fakeEventStore struct {
+	db.EventStore
+	createEvent func(ctx context.Context, req models.EventRequest) (*models.Event, error)
+}
+
+func (f *fakeEventStore) CreateEvent(ctx context.Context, req models.EventRequest) (*models.Event, error) {
+	return f.createEvent(ctx, req)
+}
+
+func TestEventHandlerCreate(t *testing.T) {
+	var gotTitle string
+	store := &fakeEventStore{
+		createEvent: func(ctx context.Context, req models.EventRequest) (*models.Event, error) {
+			gotTitle = req.Title
+			return &models.Event{ID: 1, Slug: "test-event", Title: req.Title, Status: models.StatusDraft, Visibility: models.VisibilityPublic}, nil
+		},
+	}
+	h := &EventHandler{Store: store}
+
+	body := map[string]interface{}{
+		"title":      "Test Event",
+		"event_date": "2026-06-01",
+	}
+	req := apitest.Request(http.MethodPost, "/events", body, "")
+	rec := apitest.NewRecorder()
+
+	h.create(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d; body = %s", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+	if gotTitle != "Test Event" {
+		t.Fatalf("Store.CreateEvent got title %q, want %q", gotTitle, "Test Event")
+	}
+	var got models.Event
+	if err := rec.Decode(&got); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	if got.Slug != "test-event" {
+		t.Fatalf("response slug = %q, want %q", got.Slug, "test-event")
+	}
+}
+
+func TestEventHandlerCreateRejectsUnknownField(t *testing.T) {
+	store := &fakeEventStore{
+		createEvent: func(ctx context.Context, req models.EventRequest) (*models.Event, error) {
+			t.Fatal("Store.CreateEvent should not be called for a malformed request")
+			return nil, nil
+		},
+	}
+	h := &EventHandler{Store: store}
+
+	body := map[string]interface{}{
+		"title":       "Test Event",
+		"event_date":  "2026-06-01",
+		"not_a_field": true,
+	}
+	req := apitest.Request(http.MethodPost, "/events", body, "")
+	rec := apitest.NewRecorder()
+
+	h.create(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want %d; body = %s", rec.Code, http.StatusBadRequest, rec.Body.String())
+	}
+}
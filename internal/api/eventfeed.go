@@ -0,0 +1,149 @@
+package api
+
+import (
+	"encoding/csv"
+	"net/http"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/httpjson"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/queryparam"
+)
+
+// EventFeedHandler serves GET /events/feed: a flat listing of published,
+// publicly visible events in the column set Bandsintown and Songkick both
+// document for a self-hosted event data feed, so a listing on either
+// platform can be kept current by pointing their importer at this URL
+// instead of updating each show by hand. ArtistName is fixed per feed,
+// since this API serves a single performer's calendar rather than a
+// roster of them.
+type EventFeedHandler struct {
+	Store      db.EventStore
+	VenueStore db.VenueStore
+	ArtistName string
+}
+
+func (h *EventFeedHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/events/feed", h.serve)
+}
+
+// feedRow is one line of the feed, in the field names/order Bandsintown
+// and Songkick's data feed docs use. VenueRegion is always blank: Venue
+// doesn't carry a state/province, only city and country.
+type feedRow struct {
+	ArtistName   string `json:"artist_name"`
+	EventDate    string `json:"event_datetime"`
+	Title        string `json:"title"`
+	VenueName    string `json:"venue_name"`
+	VenueCity    string `json:"venue_city"`
+	VenueRegion  string `json:"venue_region"`
+	VenueCountry string `json:"venue_country"`
+	TicketURL    string `json:"ticket_url"`
+	EventURL     string `json:"event_url"`
+}
+
+// feedCSVHeader is the column order feedRow.csvRecord writes, kept in one
+// place so the header and the records it labels can't drift apart.
+var feedCSVHeader = []string{
+	"artist_name", "event_datetime", "title", "venue_name", "venue_city",
+	"venue_region", "venue_country", "ticket_url", "event_url",
+}
+
+func (row feedRow) csvRecord() []string {
+	return []string{
+		row.ArtistName, row.EventDate, row.Title, row.VenueName, row.VenueCity,
+		row.VenueRegion, row.VenueCountry, row.TicketURL, row.EventURL,
+	}
+}
+
+func (h *EventFeedHandler) serve(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	filter := db.EventFilter{Filters: []queryparam.Filter{
+		{Field: "status", Op: queryparam.OpEq, Value: string(models.StatusPublished)},
+		{Field: "visibility", Op: queryparam.OpEq, Value: string(models.VisibilityPublic)},
+	}}
+	events, _, err := h.Store.ListEvents(r.Context(), filter)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+
+	rows := make([]feedRow, len(events))
+	for i, event := range events {
+		rows[i] = h.feedRow(r, &event)
+	}
+
+	switch r.URL.Query().Get("format") {
+	case "csv":
+		h.serveCSV(w, rows)
+	default:
+		httpjson.Respond(w, http.StatusOK, rows)
+	}
+}
+
+// feedRow builds one feed entry for event, hydrating its venue when one
+// is set and VenueStore is wired up. A venue that fails to load is left
+// blank rather than failing the whole feed, the same tolerance
+// EventHandler.expand gives a failed ?expand= lookup.
+func (h *EventFeedHandler) feedRow(r *http.Request, event *models.Event) feedRow {
+	row := feedRow{
+		ArtistName: h.ArtistName,
+		Title:      event.Title,
+		EventURL:   eventPublicURL(event.Slug),
+	}
+	if len(event.TicketOffers) > 0 {
+		row.TicketURL = event.TicketOffers[0].URL
+	}
+	var venue *models.Venue
+	if event.VenueID != nil && h.VenueStore != nil {
+		if v, err := h.VenueStore.GetVenue(r.Context(), *event.VenueID); err == nil {
+			venue = v
+			row.VenueName = v.Name
+			row.VenueCity = v.City
+			row.VenueCountry = v.Country
+		}
+	}
+	row.EventDate = localEventDateTime(event, venue)
+	return row
+}
+
+// localEventDateTime formats event's date, combined with its StartTime
+// when known, so a promoter's import shows the correct local start time
+// rather than midnight UTC. A nil StartTime keeps the plain date-only
+// format the feed always used. A StartTime with no resolvable venue
+// timezone is shown as a naive wall-clock time with no offset, since
+// there's no zone to convert it against.
+func localEventDateTime(event *models.Event, venue *models.Venue) string {
+	if event.StartTime == nil {
+		return event.EventDate.Time().Format("2006-01-02")
+	}
+	d := event.EventDate.Time()
+	t := event.StartTime.Time()
+	if venue != nil && venue.Timezone != "" {
+		if loc, err := time.LoadLocation(venue.Timezone); err == nil {
+			return time.Date(d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), 0, 0, loc).Format(time.RFC3339)
+		}
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), 0, 0, time.UTC).Format("2006-01-02T15:04:00")
+}
+
+func (h *EventFeedHandler) serveCSV(w http.ResponseWriter, rows []feedRow) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(feedCSVHeader); err != nil {
+		return
+	}
+	for _, row := range rows {
+		if err := cw.Write(row.csvRecord()); err != nil {
+			return
+		}
+	}
+	cw.Flush()
+}
@@ -0,0 +1,132 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// ics serves GET /events/{id}/ics: a single-VEVENT .ics download built
+// from the event, its venue and its programme, so it can be forwarded to
+// a collaborator as a calendar invite.
+func (h *EventHandler) ics(w http.ResponseWriter, r *http.Request, id int64) {
+	event, err := h.Store.GetEvent(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusNotFound)
+		return
+	}
+
+	var venue *models.Venue
+	if event.VenueID != nil && h.VenueStore != nil {
+		if v, err := h.VenueStore.GetVenue(r.Context(), *event.VenueID); err == nil {
+			venue = v
+		}
+	}
+	var programme *models.Programme
+	if event.ProgrammeID != nil && h.ProgrammeStore != nil {
+		if p, err := h.ProgrammeStore.GetProgramme(r.Context(), *event.ProgrammeID); err == nil {
+			programme = p
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.ics", event.Slug))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(buildICS(event, venue, programme)))
+}
+
+// buildICS renders event as a single-VEVENT RFC 5545 calendar. A known
+// StartTime is converted to UTC using venue's timezone, matching the
+// icalimport package's DTSTART formats, so a client that later re-imports
+// this file lines up with what round-tripped it. A nil StartTime produces
+// an all-day event instead, with the exclusive DTEND the spec requires.
+func buildICS(event *models.Event, venue *models.Venue, programme *models.Programme) string {
+	var lines []string
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//ntumiwa//event export//EN",
+		"BEGIN:VEVENT",
+		fmt.Sprintf("UID:event-%d@ntumiwa.example", event.ID),
+		fmt.Sprintf("DTSTAMP:%s", time.Now().UTC().Format("20060102T150405Z")),
+	)
+
+	d := event.EventDate.Time()
+	if event.StartTime == nil {
+		lines = append(lines,
+			fmt.Sprintf("DTSTART;VALUE=DATE:%s", d.Format("20060102")),
+			fmt.Sprintf("DTEND;VALUE=DATE:%s", d.AddDate(0, 0, 1).Format("20060102")),
+		)
+	} else {
+		start := icsStartTime(d, event.StartTime.Time(), venue)
+		lines = append(lines, fmt.Sprintf("DTSTART:%s", start.Format("20060102T150405Z")))
+	}
+
+	lines = append(lines, fmt.Sprintf("SUMMARY:%s", icsEscape(event.Title)))
+	if description := icsDescription(event, programme); description != "" {
+		lines = append(lines, fmt.Sprintf("DESCRIPTION:%s", icsEscape(description)))
+	}
+	if location := icsLocation(venue); location != "" {
+		lines = append(lines, fmt.Sprintf("LOCATION:%s", icsEscape(location)))
+	}
+
+	lines = append(lines, "END:VEVENT", "END:VCALENDAR")
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+// icsStartTime combines date and timeOfDay into a UTC instant, converting
+// from venue's timezone when known. A venue with no timezone set, or an
+// unresolvable one, is treated as already being in UTC.
+func icsStartTime(date, timeOfDay time.Time, venue *models.Venue) time.Time {
+	loc := time.UTC
+	if venue != nil && venue.Timezone != "" {
+		if l, err := time.LoadLocation(venue.Timezone); err == nil {
+			loc = l
+		}
+	}
+	local := time.Date(date.Year(), date.Month(), date.Day(), timeOfDay.Hour(), timeOfDay.Minute(), 0, 0, loc)
+	return local.UTC()
+}
+
+// icsDescription joins the event's programme title and notes into a
+// single DESCRIPTION field, since neither is essential on its own.
+func icsDescription(event *models.Event, programme *models.Programme) string {
+	var parts []string
+	if programme != nil && programme.Title != "" {
+		parts = append(parts, "Programme: "+programme.Title)
+	}
+	if event.Notes != "" {
+		parts = append(parts, event.Notes)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// icsLocation renders venue as a single LOCATION line.
+func icsLocation(venue *models.Venue) string {
+	if venue == nil {
+		return ""
+	}
+	parts := []string{venue.Name}
+	if venue.City != "" {
+		parts = append(parts, venue.City)
+	}
+	if venue.Country != "" {
+		parts = append(parts, venue.Country)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// icsEscape escapes the text-value special characters RFC 5545 requires,
+// the reverse of icalimport's unescapeText.
+func icsEscape(s string) string {
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\n", "\\n",
+	)
+	return replacer.Replace(s)
+}
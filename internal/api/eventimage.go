@@ -0,0 +1,80 @@
+package api
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/adamkadda/ntumiwa/internal/httpjson"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/storage"
+)
+
+// maxImageUploadBytes caps a single poster upload comfortably above a
+// compressed photo but well short of raw camera output.
+const maxImageUploadBytes = 10 << 20 // 10MB
+
+// allowedImageContentTypes maps each image type image accepts, the common
+// formats a concert poster is likely to arrive in, to the extension it's
+// stored under. The content type here is sniffed from the uploaded bytes
+// (see image()), never taken from the client-supplied Content-Type header
+// or filename, so a client can't get an .html/.svg payload served back by
+// the static file server with a browser-executable content type just by
+// lying about either one.
+var allowedImageContentTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// image serves POST /events/{id}/image: a multipart poster upload saved
+// through ImageStore and recorded as a MediaKindPoster attachment. It's
+// additive, not a replace, unlike EventRequest.Media: uploading a new
+// poster leaves an event's programme PDF or video embed alone.
+func (h *EventHandler) image(w http.ResponseWriter, r *http.Request, id int64) {
+	if h.ImageStore == nil {
+		http.Error(w, "image upload not configured", http.StatusServiceUnavailable)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImageUploadBytes)
+	if err := r.ParseMultipartForm(maxImageUploadBytes); err != nil {
+		http.Error(w, "invalid or oversized upload", http.StatusBadRequest)
+		return
+	}
+	file, _, err := r.FormFile("image")
+	if err != nil {
+		http.Error(w, "missing image file", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		http.Error(w, "failed to read upload", http.StatusBadRequest)
+		return
+	}
+
+	contentType := http.DetectContentType(data)
+	ext, ok := allowedImageContentTypes[contentType]
+	if !ok {
+		http.Error(w, "unsupported image type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	url, err := h.ImageStore.Save(r.Context(), storage.File{
+		Name:        "poster" + ext,
+		ContentType: contentType,
+		Data:        data,
+	})
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+
+	media, err := h.Store.AddEventMedia(r.Context(), id, models.MediaKindPoster, url, "")
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusCreated, media)
+}
@@ -0,0 +1,34 @@
+package api
+
+import (
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// eventURL, eventPublicURL and eventRestoreURL are the only route
+// templates a hypermedia link is ever built from, so a path change (like
+// the /v1 prefix landing) is a one-line fix here instead of a search
+// across every handler that hand-built one.
+func eventURL(id int64) string          { return fmt.Sprintf("/events/%d", id) }
+func eventPublicURL(slug string) string { return fmt.Sprintf("/events/%s", slug) }
+func eventRestoreURL(id int64) string   { return fmt.Sprintf("/events/%d/restore", id) }
+
+// eventLinks builds the _links section for an event response: self by
+// numeric id always, plus the slug-based public URL GET /events/{id}
+// also accepts, and (for trashed events) the restore action. Publishing
+// and archiving go through the status route, which takes its target
+// status in the request body, and there's no dedicated venue/programme
+// route, so no link is built for them.
+func eventLinks(event *models.Event, trashed bool) map[string]string {
+	links := map[string]string{
+		"self": eventURL(event.ID),
+	}
+	if event.Slug != "" {
+		links["public"] = eventPublicURL(event.Slug)
+	}
+	if trashed {
+		links["restore"] = eventRestoreURL(event.ID)
+	}
+	return links
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Hub fans out server-sent events to every connected client, so an admin
+// edit shows up in other open tabs (and the public site) without polling.
+type Hub struct {
+	mu   sync.Mutex
+	subs map[chan sseEvent]struct{}
+}
+
+type sseEvent struct {
+	name    string
+	payload string
+}
+
+// NewHub returns an empty Hub ready to accept subscribers.
+func NewHub() *Hub {
+	return &Hub{subs: make(map[chan sseEvent]struct{})}
+}
+
+// Broadcast sends name/payload to every currently connected client. Slow
+// subscribers are dropped rather than allowed to block the publisher.
+func (h *Hub) Broadcast(name, payload string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs {
+		select {
+		case ch <- sseEvent{name: name, payload: payload}:
+		default:
+			delete(h.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+func (h *Hub) subscribe() chan sseEvent {
+	ch := make(chan sseEvent, 8)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *Hub) unsubscribe(ch chan sseEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[ch]; ok {
+		delete(h.subs, ch)
+		close(ch)
+	}
+}
+
+// ServeHTTP streams events to the client as they're broadcast, using the
+// standard text/event-stream framing.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	ch := h.subscribe()
+	defer h.unsubscribe(ch)
+
+	for {
+		select {
+		case ev, ok := <-ch:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.name, ev.payload)
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
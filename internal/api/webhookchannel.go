@@ -0,0 +1,200 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/httpjson"
+	"github.com/adamkadda/ntumiwa/internal/webhooks"
+)
+
+// WebhookChannelHandler serves the /webhook-channels routes against a
+// WebhookChannelStore. Sender is only needed for the test-delivery
+// action; a handler built without one just answers 503 for that route
+// instead of failing to construct.
+type WebhookChannelHandler struct {
+	Store  db.WebhookChannelStore
+	Sender webhooks.Sender
+}
+
+func (h *WebhookChannelHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/webhook-channels", h.collection)
+	mux.HandleFunc("/webhook-channels/trash", h.trash)
+	mux.HandleFunc("/webhook-channels/", h.item)
+}
+
+func (h *WebhookChannelHandler) collection(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.list(w, r)
+	case http.MethodPost:
+		h.create(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *WebhookChannelHandler) item(w http.ResponseWriter, r *http.Request) {
+	rest := strings.TrimPrefix(r.URL.Path, "/webhook-channels/")
+	idStr, action, hasAction := strings.Cut(rest, "/")
+
+	id, ok := parseWebhookChannelID(w, idStr)
+	if !ok {
+		return
+	}
+
+	if hasAction {
+		if action == "restore" && r.Method == http.MethodPost {
+			h.restore(w, r, id)
+			return
+		}
+		if action == "test" && r.Method == http.MethodPost {
+			h.test(w, r, id)
+			return
+		}
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.get(w, r, id)
+	case http.MethodPatch, http.MethodPut:
+		h.update(w, r, id)
+	case http.MethodDelete:
+		h.delete(w, r, id)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// parseWebhookChannelID parses idStr as a numeric webhook channel id,
+// writing a 400 and reporting failure if it isn't one.
+func parseWebhookChannelID(w http.ResponseWriter, idStr string) (int64, bool) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid webhook channel id", http.StatusBadRequest)
+		return 0, false
+	}
+	return id, true
+}
+
+func (h *WebhookChannelHandler) list(w http.ResponseWriter, r *http.Request) {
+	channels, err := h.Store.ListWebhookChannels(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	_ = httpjson.StreamArray(w, http.StatusOK, channels, httpjson.Fields(r))
+}
+
+func (h *WebhookChannelHandler) get(w http.ResponseWriter, r *http.Request, id int64) {
+	channel, err := h.Store.GetWebhookChannel(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusNotFound)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, channel)
+}
+
+// webhookChannelRequest is the JSON body accepted by create and update: the
+// channel's editable fields, of which the store requires name and url,
+// mirroring the plain-string-params signature of the
+// CreateWebhookChannel/UpdateWebhookChannel store methods.
+// PayloadMode may be left empty, which the store treats as "template".
+type webhookChannelRequest struct {
+	Name             string `json:"name"`
+	URL              string `json:"url"`
+	PayloadMode      string `json:"payload_mode"`
+	TextTemplate     string `json:"text_template"`
+	ImageURLTemplate string `json:"image_url_template"`
+	LinkTemplate     string `json:"link_template"`
+}
+
+func (h *WebhookChannelHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req webhookChannelRequest
+	if err := httpjson.Decode(w, r, &req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	channel, err := h.Store.CreateWebhookChannel(r.Context(), req.Name, req.URL, req.PayloadMode, req.TextTemplate, req.ImageURLTemplate, req.LinkTemplate)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusCreated, channel)
+}
+
+func (h *WebhookChannelHandler) update(w http.ResponseWriter, r *http.Request, id int64) {
+	var req webhookChannelRequest
+	if err := httpjson.Decode(w, r, &req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+	channel, err := h.Store.UpdateWebhookChannel(r.Context(), id, req.Name, req.URL, req.PayloadMode, req.TextTemplate, req.ImageURLTemplate, req.LinkTemplate)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, channel)
+}
+
+// delete moves the webhook channel to the trash rather than removing it
+// outright; see trash and restore.
+func (h *WebhookChannelHandler) delete(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.Store.DeleteWebhookChannel(r.Context(), id); err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WebhookChannelHandler) trash(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	channels, err := h.Store.ListTrashedWebhookChannels(r.Context())
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	_ = httpjson.StreamArray(w, http.StatusOK, channels, httpjson.Fields(r))
+}
+
+func (h *WebhookChannelHandler) restore(w http.ResponseWriter, r *http.Request, id int64) {
+	channel, err := h.Store.RestoreWebhookChannel(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusInternalServerError)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, channel)
+}
+
+// test serves POST /webhook-channels/{id}/test: renders channel's payload
+// against a fabricated example event and delivers it immediately, outside
+// the outbox, so an admin can confirm a channel is wired up correctly
+// without waiting for (or faking) a real event publish.
+func (h *WebhookChannelHandler) test(w http.ResponseWriter, r *http.Request, id int64) {
+	if h.Sender == nil {
+		http.Error(w, "test delivery is not configured", http.StatusServiceUnavailable)
+		return
+	}
+	channel, err := h.Store.GetWebhookChannel(r.Context(), id)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusNotFound)
+		return
+	}
+	payload, err := webhooks.RenderExample(channel)
+	if err != nil {
+		writeError(r.Context(), w, err, http.StatusBadRequest)
+		return
+	}
+	if err := h.Sender.Send(r.Context(), channel.URL, payload); err != nil {
+		writeError(r.Context(), w, err, http.StatusBadGateway)
+		return
+	}
+	httpjson.Respond(w, http.StatusOK, payload)
+}
@@ -0,0 +1,23 @@
+package apiclient
+
+import "context"
+
+// ctxKey is unexported so callers can't collide with other packages' context
+// keys.
+type ctxKey int
+
+const tokenKey ctxKey = iota
+
+// WithToken attaches the admin user's session/API token to ctx, so a
+// downstream Client call made on their behalf is forwarded with it instead
+// of falling back to a shared service identity. Handlers should call this
+// once per request, right after resolving the session.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey, token)
+}
+
+// tokenFromContext returns the token set by WithToken, if any.
+func tokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenKey).(string)
+	return token, ok && token != ""
+}
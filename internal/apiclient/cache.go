@@ -0,0 +1,148 @@
+package apiclient
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CacheConfig sets per-resource TTLs for the client's read cache. A zero
+// value disables caching for that resource.
+type CacheConfig struct {
+	PerformancesTTL     time.Duration
+	PerformanceStatsTTL time.Duration
+	BiographyTTL        time.Duration
+}
+
+// entry holds a cached value alongside when it was fetched, so callers can
+// tell how stale it is.
+type entry struct {
+	value      interface{}
+	fetchAt    time.Time
+	ttl        time.Duration
+	refresh    func(ctx context.Context) (interface{}, error)
+	mu         sync.Mutex
+	refreshing bool // background refresh already in flight
+}
+
+func (e *entry) stale() bool {
+	return time.Since(e.fetchAt) > e.ttl
+}
+
+// readCache is a small in-memory cache keyed by resource name, giving the
+// public frontend somewhere to fall back to when the API is briefly down.
+// A stale entry is served immediately while a refresh happens in the
+// background (stale-while-revalidate), rather than blocking the request.
+type readCache struct {
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+func newReadCache() *readCache {
+	return &readCache{entries: make(map[string]*entry)}
+}
+
+// get returns a cached value for key if present, refreshing it inline on a
+// cold cache and in the background once it's gone stale.
+func (c *readCache) get(ctx context.Context, key string, ttl time.Duration, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if ttl <= 0 {
+		return fetch(ctx)
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if !ok {
+		e = &entry{ttl: ttl, refresh: fetch}
+		c.entries[key] = e
+	}
+	c.mu.Unlock()
+
+	e.mu.Lock()
+	hasValue := e.value != nil
+	needsRefresh := !hasValue || e.stale()
+	inFlight := e.refreshing
+	if needsRefresh && !inFlight {
+		e.refreshing = true
+	}
+	e.mu.Unlock()
+
+	if !hasValue {
+		// Cold cache: fetch synchronously so the caller gets a real value.
+		v, err := fetch(ctx)
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.refreshing = false
+		if err != nil {
+			return nil, err
+		}
+		e.value, e.fetchAt = v, time.Now()
+		return v, nil
+	}
+
+	if needsRefresh && !inFlight {
+		go func() {
+			// Detached from the caller's context/deadline: a slow refresh
+			// shouldn't be cancelled just because the triggering request
+			// returned its stale value and moved on.
+			v, err := fetch(context.Background())
+			e.mu.Lock()
+			defer e.mu.Unlock()
+			e.refreshing = false
+			if err == nil {
+				e.value, e.fetchAt = v, time.Now()
+			}
+		}()
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.value, nil
+}
+
+// GetPerformances returns the cached performance list, refreshing it in the
+// background once PerformancesTTL has elapsed.
+func (c *Client) GetPerformances(ctx context.Context) ([]Performance, error) {
+	v, err := c.cache.get(ctx, "performances", c.cacheCfg.PerformancesTTL, func(ctx context.Context) (interface{}, error) {
+		var out []Performance
+		if err := c.Get(ctx, "/performances", &out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]Performance), nil
+}
+
+// GetBiography returns the cached biography, refreshing it in the
+// background once BiographyTTL has elapsed.
+func (c *Client) GetBiography(ctx context.Context) (Biography, error) {
+	v, err := c.cache.get(ctx, "biography", c.cacheCfg.BiographyTTL, func(ctx context.Context) (interface{}, error) {
+		var out Biography
+		if err := c.Get(ctx, "/biography", &out); err != nil {
+			return Biography{}, err
+		}
+		return out, nil
+	})
+	if err != nil {
+		return Biography{}, err
+	}
+	return v.(Biography), nil
+}
+
+// GetPerformanceStats returns the cached performance statistics, refreshing
+// them in the background once PerformanceStatsTTL has elapsed.
+func (c *Client) GetPerformanceStats(ctx context.Context) (PerformanceStats, error) {
+	v, err := c.cache.get(ctx, "performance_stats", c.cacheCfg.PerformanceStatsTTL, func(ctx context.Context) (interface{}, error) {
+		var out PerformanceStats
+		if err := c.Get(ctx, "/stats/performances", &out); err != nil {
+			return PerformanceStats{}, err
+		}
+		return out, nil
+	})
+	if err != nil {
+		return PerformanceStats{}, err
+	}
+	return v.(PerformanceStats), nil
+}
@@ -0,0 +1,7 @@
+// Package gen holds request/response bindings generated from api/openapi.yaml.
+// Run `go generate ./...` after editing the spec; the handwritten client in
+// internal/apiclient wraps these types instead of redeclaring them, so the
+// two can't drift apart silently.
+package gen
+
+//go:generate go run github.com/deepmap/oapi-codegen/v2/cmd/oapi-codegen --config oapi-codegen.yaml ../../../api/openapi.yaml
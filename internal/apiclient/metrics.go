@@ -0,0 +1,86 @@
+package apiclient
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// EndpointStats is a snapshot of the request counters for one endpoint.
+type EndpointStats struct {
+	Requests     int64
+	Errors       int64
+	TotalLatency time.Duration
+}
+
+// metrics tracks per-endpoint latency and error counts so they can be
+// surfaced on a /status or /debug/metrics endpoint without pulling in a
+// full metrics client just for this.
+type metrics struct {
+	mu    sync.Mutex
+	stats map[string]*EndpointStats
+}
+
+func newMetrics() *metrics {
+	return &metrics{stats: make(map[string]*EndpointStats)}
+}
+
+func (m *metrics) record(endpoint string, latency time.Duration, isError bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.stats[endpoint]
+	if !ok {
+		s = &EndpointStats{}
+		m.stats[endpoint] = s
+	}
+	s.Requests++
+	s.TotalLatency += latency
+	if isError {
+		s.Errors++
+	}
+}
+
+// Snapshot returns a copy of the current per-endpoint stats, safe to read
+// concurrently with further requests.
+func (m *metrics) Snapshot() map[string]EndpointStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	out := make(map[string]EndpointStats, len(m.stats))
+	for k, v := range m.stats {
+		out[k] = *v
+	}
+	return out
+}
+
+// instrumentedTransport records latency/error metrics per endpoint and
+// propagates a W3C traceparent header, so cross-service calls line up with
+// the API's own request traces.
+type instrumentedTransport struct {
+	next    http.RoundTripper
+	metrics *metrics
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if tp, ok := traceparentFromContext(req.Context()); ok {
+		req.Header.Set("traceparent", tp)
+	} else {
+		req.Header.Set("traceparent", newTraceparent())
+	}
+
+	endpoint := req.Method + " " + req.URL.Path
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	latency := time.Since(start)
+
+	isError := err != nil || (resp != nil && resp.StatusCode >= 500)
+	t.metrics.record(endpoint, latency, isError)
+
+	return resp, err
+}
+
+// Metrics returns a snapshot of per-endpoint latency/error counters.
+func (c *Client) Metrics() map[string]EndpointStats {
+	return c.metrics.Snapshot()
+}
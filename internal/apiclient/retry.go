@@ -0,0 +1,221 @@
+package apiclient
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// idempotentMethods is the set of HTTP methods safe to retry automatically.
+// POST/PATCH are excluded since a mutation may already have applied.
+var idempotentMethods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodHead:   true,
+	http.MethodPut:    true,
+	http.MethodDelete: true,
+}
+
+// RetryConfig controls the retry/backoff behaviour of a retryTransport.
+type RetryConfig struct {
+	MaxAttempts int           // total attempts including the first; 1 disables retries, and New substitutes the default config when this is 0
+	BaseDelay   time.Duration // delay before the first retry
+	MaxDelay    time.Duration // cap on backoff delay
+	BreakerCfg  BreakerConfig
+}
+
+// BreakerConfig controls when the circuit breaker opens and how long it
+// stays open before allowing a trial request through.
+type BreakerConfig struct {
+	FailureThreshold int           // consecutive failures before opening
+	OpenDuration     time.Duration // how long to fail fast once open
+}
+
+func defaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    2 * time.Second,
+		BreakerCfg: BreakerConfig{
+			FailureThreshold: 5,
+			OpenDuration:     30 * time.Second,
+		},
+	}
+}
+
+// BreakerState is the externally observable state of a circuit breaker, for
+// health reporting.
+type BreakerState int
+
+const (
+	BreakerClosed BreakerState = iota
+	BreakerOpen
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// circuitBreaker fails fast once too many consecutive requests have failed,
+// so a downed API doesn't get hammered by every retrying frontend request.
+type circuitBreaker struct {
+	cfg BreakerConfig
+
+	mu            sync.Mutex
+	failures      int
+	openedAt      time.Time
+	state         BreakerState
+	trialInFlight bool // true while a half-open probe request is outstanding
+}
+
+func newCircuitBreaker(cfg BreakerConfig) *circuitBreaker {
+	return &circuitBreaker{cfg: cfg, state: BreakerClosed}
+}
+
+var ErrCircuitOpen = errors.New("apiclient: circuit breaker open")
+
+// allow reports whether a request may proceed, transitioning Open -> HalfOpen
+// once the cooldown has elapsed. While half-open, only a single trial
+// request is let through at a time; every other concurrent caller is
+// turned away until that trial's outcome is recorded, so a downed API
+// isn't immediately re-hammered by every request that was queued up
+// waiting for it.
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) >= b.cfg.OpenDuration {
+			b.state = BreakerHalfOpen
+			b.trialInFlight = true
+			return true
+		}
+		return false
+	case BreakerHalfOpen:
+		if b.trialInFlight {
+			return false
+		}
+		b.trialInFlight = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (b *circuitBreaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+	b.state = BreakerClosed
+	b.trialInFlight = false
+}
+
+func (b *circuitBreaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+	}
+	b.trialInFlight = false
+}
+
+// State returns the breaker's current state, for /status-style health
+// reporting.
+func (b *circuitBreaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// retryTransport wraps an http.RoundTripper with jittered-backoff retries of
+// idempotent requests and a circuit breaker guarding the whole thing.
+type retryTransport struct {
+	next    http.RoundTripper
+	cfg     RetryConfig
+	breaker *circuitBreaker
+}
+
+func newRetryTransport(next http.RoundTripper, cfg RetryConfig) *retryTransport {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+	return &retryTransport{
+		next:    next,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(cfg.BreakerCfg),
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.breaker.allow() {
+		return nil, ErrCircuitOpen
+	}
+
+	attempts := 1
+	if idempotentMethods[req.Method] {
+		attempts = t.cfg.MaxAttempts
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-req.Context().Done():
+				return nil, req.Context().Err()
+			case <-time.After(t.backoff(attempt)):
+			}
+		}
+
+		resp, err = t.next.RoundTrip(req)
+		if err == nil && resp.StatusCode < 500 {
+			t.breaker.recordSuccess()
+			return resp, nil
+		}
+		// Only close the body when another attempt follows; the final
+		// attempt's response (success or not) is returned to the caller,
+		// who owns closing it.
+		if attempt < attempts-1 && resp != nil {
+			resp.Body.Close()
+		}
+	}
+
+	t.breaker.recordFailure()
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// backoff returns a jittered exponential delay for the given retry attempt
+// (1-indexed), capped at cfg.MaxDelay.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	delay := t.cfg.BaseDelay << uint(attempt-1)
+	if delay > t.cfg.MaxDelay || delay <= 0 {
+		delay = t.cfg.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay) / 2 + 1))
+	return delay/2 + jitter
+}
+
+// BreakerStatus reports the circuit breaker's state, for health endpoints.
+func (c *Client) BreakerStatus() BreakerState {
+	if t, ok := c.http.Transport.(*retryTransport); ok {
+		return t.breaker.State()
+	}
+	return BreakerClosed
+}
@@ -0,0 +1,36 @@
+package apiclient
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const traceparentKey ctxKey = iota + 1
+
+// WithTraceparent attaches a W3C traceparent header value to ctx, so calls
+// made through it are propagated as part of the same distributed trace as
+// the inbound request that produced it.
+func WithTraceparent(ctx context.Context, traceparent string) context.Context {
+	return context.WithValue(ctx, traceparentKey, traceparent)
+}
+
+func traceparentFromContext(ctx context.Context) (string, bool) {
+	tp, ok := ctx.Value(traceparentKey).(string)
+	return tp, ok && tp != ""
+}
+
+// newTraceparent generates a fresh W3C traceparent header value
+// ("version-trace_id-parent_id-flags") for calls that have no inbound trace
+// to continue, so they still show up as their own trace in dashboards.
+func newTraceparent() string {
+	traceID := randomHex(16)
+	spanID := randomHex(8)
+	return "00-" + traceID + "-" + spanID + "-01"
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,16 @@
+package apiclient
+
+import "github.com/adamkadda/ntumiwa/internal/apiclient/gen"
+
+// Performance is the public shape of a performance listing served by the
+// API's /performances endpoint. It's an alias of the generated binding so
+// callers of this package don't need to import internal/apiclient/gen
+// directly.
+type Performance = gen.Performance
+
+// Biography is the public shape of the /biography endpoint.
+type Biography = gen.Biography
+
+// PerformanceStats is the public shape of the /stats/performances
+// endpoint.
+type PerformanceStats = gen.PerformanceStats
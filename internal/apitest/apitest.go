@@ -0,0 +1,61 @@
+// Package apitest is a small httptest-style helper for testing
+// internal/api handlers directly, without going through cmd/api's
+// middleware chain. This repo has no session subsystem or per-request
+// logger yet — a handler learns who's calling it from auth.Actor(ctx),
+// set by cmd/api's actorMiddleware off the X-Actor header, and debug
+// query logging is a plain context flag (db.WithDebugLog) rather than a
+// logger value — so Request sets the actor directly instead of a generic
+// "inject a session" step that doesn't exist here. It does not set the
+// db.WithDebugLog flag.
+package apitest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+)
+
+// Request builds an *http.Request for a handler under test. body is
+// marshaled as the JSON request body and Content-Type is set to
+// application/json; a nil body produces a bodyless request. actor, if
+// non-empty, is stashed in the request's context via auth.WithActor, the
+// same identity actorMiddleware would have set from the X-Actor header.
+func Request(method, target string, body interface{}, actor string) *http.Request {
+	var r *http.Request
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			panic(fmt.Sprintf("apitest: marshal request body: %v", err))
+		}
+		r = httptest.NewRequest(method, target, bytes.NewReader(b))
+		r.Header.Set("Content-Type", "application/json")
+	} else {
+		r = httptest.NewRequest(method, target, nil)
+	}
+	if actor != "" {
+		r = r.WithContext(auth.WithActor(r.Context(), actor))
+	}
+	return r
+}
+
+// Recorder wraps httptest.ResponseRecorder with a Decode helper, so a
+// handler test can go straight from the recorded body to the response
+// type it expects without an intermediate json.NewDecoder call at every
+// call site.
+type Recorder struct {
+	*httptest.ResponseRecorder
+}
+
+// NewRecorder returns a ready-to-use Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{ResponseRecorder: httptest.NewRecorder()}
+}
+
+// Decode unmarshals the recorded response body into v.
+func (r *Recorder) Decode(v interface{}) error {
+	return json.NewDecoder(r.Body).Decode(v)
+}
@@ -0,0 +1,93 @@
+// Package assets embeds the site's CSS and JS so the admin and public apps
+// can serve them without an external build pipeline.
+package assets
+
+import (
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed css js
+var fsys embed.FS
+
+// FS is the embedded static asset tree, rooted at the directory containing
+// css/ and js/.
+var FS = fsys
+
+// maxAge is used for the Cache-Control header on hashed asset responses.
+// Since the URL changes whenever the content does, it's safe to cache
+// these responses indefinitely.
+const maxAge = 365 * 24 * time.Hour
+
+var (
+	manifestOnce sync.Once
+	manifest     map[string]string // logical path -> hashed path, e.g. "css/base.css" -> "css/base.a1b2c3d4.css"
+	reverse      map[string]string // hashed path -> logical path
+)
+
+func buildManifest() {
+	manifest = make(map[string]string)
+	reverse = make(map[string]string)
+
+	_ = fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(b)
+		hash := hex.EncodeToString(sum[:])[:8]
+
+		ext := path.Ext(p)
+		hashed := strings.TrimSuffix(p, ext) + "." + hash + ext
+
+		manifest[p] = hashed
+		reverse[hashed] = p
+		return nil
+	})
+}
+
+// Path returns the content-hashed URL path for a logical asset path such as
+// "css/base.css". Templates should call this instead of hard-coding
+// filenames, so a deploy that changes a file also busts the cache.
+func Path(logical string) string {
+	manifestOnce.Do(buildManifest)
+	if hashed, ok := manifest[logical]; ok {
+		return "/static/" + hashed
+	}
+	// Unknown asset: fall back to the unhashed path rather than panicking in
+	// a template helper.
+	return "/static/" + logical
+}
+
+// Handler serves the embedded assets under prefix, rewriting hashed request
+// paths back to their real file and attaching far-future cache headers.
+func Handler(prefix string) http.Handler {
+	manifestOnce.Do(buildManifest)
+
+	fileServer := http.FileServer(http.FS(fsys))
+	return http.StripPrefix(prefix, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := strings.TrimPrefix(r.URL.Path, "/")
+
+		real := requested
+		if logical, ok := reverse[requested]; ok {
+			real = logical
+			w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d, immutable", int(maxAge.Seconds())))
+		}
+
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL.Path = "/" + real
+		fileServer.ServeHTTP(w, r2)
+	}))
+}
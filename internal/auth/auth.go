@@ -0,0 +1,26 @@
+// Package auth carries the identity of the acting admin user through a
+// request's context, so lower layers (the db package's created_by/updated_by
+// columns, audit logging) can attribute a change without every function
+// signature growing a userID parameter.
+//
+// There's no session subsystem yet, so the identity is currently just
+// whatever the caller puts in the X-Actor header; cmd/api's actor middleware
+// is the sole place that reads it off the wire.
+package auth
+
+import "context"
+
+type ctxKey int
+
+const actorKey ctxKey = 0
+
+// WithActor returns a context carrying actor as the acting user's identity.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+// Actor returns the identity stashed by WithActor, or "" if none was set.
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey).(string)
+	return actor
+}
@@ -0,0 +1,45 @@
+// Package buildinfo holds version metadata stamped in at build time via
+// -ldflags, so binaries can report exactly what's deployed.
+package buildinfo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Version, Commit, BuildTime and Features are overridden at build time,
+// e.g.:
+//
+//	go build -ldflags "-X github.com/adamkadda/ntumiwa/internal/buildinfo.Version=1.2.3 \
+//	  -X github.com/adamkadda/ntumiwa/internal/buildinfo.Commit=$(git rev-parse HEAD) \
+//	  -X github.com/adamkadda/ntumiwa/internal/buildinfo.BuildTime=$(date -u +%FT%TZ) \
+//	  -X github.com/adamkadda/ntumiwa/internal/buildinfo.Features=mailer,cron,errreport"
+var (
+	Version   = "dev"
+	Commit    = "unknown"
+	BuildTime = "unknown"
+	Features  = ""
+)
+
+// startTime records when the process started, for Uptime.
+var startTime = time.Now()
+
+// String returns a one-line summary suitable for a --version flag.
+func String(binary string) string {
+	return fmt.Sprintf("%s %s (commit %s, built %s)", binary, Version, Commit, BuildTime)
+}
+
+// EnabledFeatures returns the Features build flag as a slice, or nil if
+// none were stamped in.
+func EnabledFeatures() []string {
+	if Features == "" {
+		return nil
+	}
+	return strings.Split(Features, ",")
+}
+
+// Uptime returns how long the process has been running.
+func Uptime() time.Duration {
+	return time.Since(startTime)
+}
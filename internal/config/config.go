@@ -0,0 +1,582 @@
+// Package config loads the API's runtime configuration from environment
+// variables, optionally overlaid with a YAML file for local dev and
+// multi-environment deployments.
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/adamkadda/ntumiwa/internal/errreport"
+	"github.com/adamkadda/ntumiwa/internal/logging"
+	"github.com/adamkadda/ntumiwa/internal/mailer"
+	"github.com/adamkadda/ntumiwa/internal/secrets"
+	"github.com/adamkadda/ntumiwa/internal/storage"
+	"gopkg.in/yaml.v3"
+)
+
+// Kind identifies which binary a Config profile belongs to. The three
+// binaries share the Log/Secrets machinery below but need different
+// sub-configs and validation: the API talks to Postgres, the admin and
+// public frontends talk to the API.
+type Kind string
+
+const (
+	KindAPI    Kind = "api"
+	KindAdmin  Kind = "admin"
+	KindPublic Kind = "public"
+)
+
+// Config is the effective configuration for one binary. Every binary loads
+// the same struct; which sub-configs matter (and get validated) depends on
+// Kind.
+type Config struct {
+	Kind Kind `yaml:"-"`
+
+	Postgres       PostgresConfig       `yaml:"postgres"`
+	Server         ServerConfig         `yaml:"server"`
+	Frontend       FrontendConfig       `yaml:"frontend"`
+	Log            LogConfig            `yaml:"log"`
+	Secrets        SecretsConfig        `yaml:"secrets"`
+	RateLimit      RateLimitConfig      `yaml:"rate_limit"`
+	ActorRateLimit ActorRateLimitConfig `yaml:"actor_rate_limit"`
+	Cache          CacheConfig          `yaml:"cache"`
+	Trash          TrashConfig          `yaml:"trash"`
+	Cron           CronConfig           `yaml:"cron"`
+	Mailer         MailerConfig         `yaml:"mailer"`
+	EventReminder  EventReminderConfig  `yaml:"event_reminder"`
+	CalendarImport CalendarImportConfig `yaml:"calendar_import"`
+	GoogleCalendar GoogleCalendarConfig `yaml:"google_calendar"`
+	YouTube        YouTubeConfig        `yaml:"youtube"`
+	Newsletter     NewsletterConfig     `yaml:"newsletter"`
+	EventFeed      EventFeedConfig      `yaml:"event_feed"`
+	Storage        StorageConfig        `yaml:"storage"`
+	ErrorReporting ErrorReportingConfig `yaml:"error_reporting"`
+	DebugLog       DebugLogConfig       `yaml:"debug_log"`
+
+	SecretKey string `yaml:"-"`
+}
+
+// FrontendConfig holds the settings specific to the admin and public
+// binaries: where the API lives and what port to listen on.
+type FrontendConfig struct {
+	APIBaseURL string `yaml:"api_base_url"`
+	Port       int    `yaml:"port"`
+}
+
+// RateLimitConfig holds request rate limiting settings. It's one of the
+// settings that can be changed at runtime via Reload, without dropping
+// in-memory sessions.
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	Burst             int     `yaml:"burst"`
+}
+
+// ActorRateLimitConfig holds request quotas keyed by the authenticated
+// caller's identity (the X-Actor header), separate from RateLimitConfig's
+// per-IP limits. There's no role table this ties into yet, so Roles maps
+// an actor's identity straight to its own quota; anyone not listed falls
+// back to Default. Also reloadable.
+type ActorRateLimitConfig struct {
+	Default RateLimitConfig            `yaml:"default"`
+	Roles   map[string]RateLimitConfig `yaml:"roles"`
+}
+
+// CacheConfig holds TTLs for server-side response caches. Also reloadable.
+type CacheConfig struct {
+	PerformancesTTLSeconds     int `yaml:"performances_ttl_seconds"`
+	PerformanceStatsTTLSeconds int `yaml:"performance_stats_ttl_seconds"`
+	BiographyTTLSeconds        int `yaml:"biography_ttl_seconds"`
+}
+
+// TrashConfig controls how long soft-deleted rows sit in the trash before
+// the purge job hard-deletes them.
+type TrashConfig struct {
+	RetentionDays int `yaml:"retention_days"`
+}
+
+// CronConfig holds the cron schedules for the scheduler's registered
+// tasks. Each is a standard 5-field cron expression.
+type CronConfig struct {
+	PurgeSchedule          string `yaml:"purge_schedule"`
+	EventReminderSchedule  string `yaml:"event_reminder_schedule"`
+	TicketStatusSchedule   string `yaml:"ticket_status_schedule"`
+	CalendarImportSchedule string `yaml:"calendar_import_schedule"`
+}
+
+// EventReminderConfig controls the pre-event reminder digest: how far
+// ahead of an event's date to start flagging it, and who to email about
+// it. AdminEmail is left empty by default; the digest task doesn't
+// register until it's set.
+type EventReminderConfig struct {
+	LeadDays   int    `yaml:"lead_days"`
+	AdminEmail string `yaml:"admin_email"`
+}
+
+// CalendarImportConfig controls the external hold-calendar sync: the .ics
+// URL to poll. URL is left empty by default; the import task doesn't
+// register until it's set.
+type CalendarImportConfig struct {
+	URL string `yaml:"url"`
+}
+
+// GoogleCalendarConfig controls the push sync of published events into a
+// Google Calendar. CalendarID is left empty by default; the job worker
+// doesn't start until both it and Token are set. Token is resolved via the
+// secrets provider like SecretKey, so it's excluded from the YAML file.
+type GoogleCalendarConfig struct {
+	CalendarID string `yaml:"calendar_id"`
+	Token      string `yaml:"-"`
+
+	WorkerConcurrency int `yaml:"worker_concurrency"`
+}
+
+// YouTubeConfig controls video metadata enrichment via the YouTube Data
+// API. APIKey is left empty by default; the metadata job worker doesn't
+// start until it's set. APIKey is resolved via the secrets provider like
+// SecretKey, so it's excluded from the YAML file.
+type YouTubeConfig struct {
+	APIKey string `yaml:"-"`
+}
+
+// NewsletterConfig controls syncing subscribers and drafting campaigns
+// through a newsletter provider (Buttondown-compatible). APIKey is left
+// empty by default; the job workers don't start until it's set. APIKey is
+// resolved via the secrets provider like SecretKey, so it's excluded from
+// the YAML file.
+type NewsletterConfig struct {
+	APIKey string `yaml:"-"`
+}
+
+// EventFeedConfig names the performer in the concert-discovery feed at
+// GET /events/feed, since Bandsintown and Songkick both key a listing to
+// an artist name rather than to this site. There's no "unconfigured"
+// state to gate on: an empty ArtistName just means the feed's
+// artist_name column comes out blank, a config mistake rather than
+// something worth failing the request over.
+type EventFeedConfig struct {
+	ArtistName string `yaml:"artist_name"`
+}
+
+// StorageConfig configures where uploaded files (currently just event
+// poster images) are saved. LocalDir and LocalPublicURL only matter when
+// Kind is local, the default: LocalPublicURL is whatever base URL serves
+// LocalDir as static files.
+type StorageConfig struct {
+	Kind storage.Kind `yaml:"kind"`
+
+	LocalDir       string `yaml:"local_dir"`
+	LocalPublicURL string `yaml:"local_public_url"`
+}
+
+// MailerConfig configures the mailer.Sender cmd/api uses to send the
+// upcoming-events digest; no other mail is sent through it. SMTPPwd
+// and APIKey are resolved via the secrets provider like SecretKey, so
+// they're excluded from the YAML file.
+type MailerConfig struct {
+	Kind mailer.Kind `yaml:"kind"`
+	From string      `yaml:"from"`
+
+	SMTPHost string `yaml:"smtp_host"`
+	SMTPPort int    `yaml:"smtp_port"`
+	SMTPUser string `yaml:"smtp_user"`
+	SMTPPwd  string `yaml:"-"`
+
+	APIEndpoint string `yaml:"api_endpoint"`
+	APIKey      string `yaml:"-"`
+}
+
+// ErrorReportingConfig configures the errreport.Reporter that recovered
+// panics and handler 500s are sent to. DSN is resolved via the secrets
+// provider like SecretKey, so it's excluded from the YAML file.
+type ErrorReportingConfig struct {
+	Kind        errreport.Kind `yaml:"kind"`
+	DSN         string         `yaml:"-"`
+	Environment string         `yaml:"environment"`
+}
+
+// DebugLogConfig lists the source IPs that get elevated debug-level
+// request logging (including db query logging) without needing the
+// X-Debug-Log header, for troubleshooting from a known bastion or ops box.
+type DebugLogConfig struct {
+	AllowedIPs []string `yaml:"allowed_ips"`
+}
+
+// SecretsConfig selects and configures the secrets.Provider used to
+// resolve POSTGRES_PWD and SECRET_KEY.
+type SecretsConfig struct {
+	Kind      secrets.Kind `yaml:"kind"`
+	FileDir   string       `yaml:"file_dir"`
+	VaultAddr string       `yaml:"vault_addr"`
+}
+
+// PostgresConfig holds the database connection settings.
+type PostgresConfig struct {
+	Host     string         `yaml:"host"`
+	Port     int            `yaml:"port"`
+	User     string         `yaml:"user"`
+	Pwd      string         `yaml:"pwd"`
+	DB       string         `yaml:"db"`
+	Timeouts TimeoutsConfig `yaml:"timeouts"`
+
+	// ReadReplicaHost, if set, routes Get*/List* queries to a replica at
+	// this host instead of the primary. Empty disables read routing.
+	ReadReplicaHost string `yaml:"read_replica_host"`
+}
+
+// TimeoutsConfig holds the per-operation-class query timeouts, in seconds.
+// Reports (e.g. season/tour rollups) tend to scan far more rows than a
+// single-row read or write, so they get their own, larger, budget.
+type TimeoutsConfig struct {
+	ReadSeconds   int `yaml:"read_seconds"`
+	WriteSeconds  int `yaml:"write_seconds"`
+	ReportSeconds int `yaml:"report_seconds"`
+}
+
+// DSN builds a libpq connection string from the Postgres settings.
+func (p PostgresConfig) DSN() string {
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s", p.User, p.Pwd, p.Host, p.Port, p.DB)
+}
+
+// ReadReplicaDSN builds the libpq connection string for the read replica,
+// or "" if none is configured.
+func (p PostgresConfig) ReadReplicaDSN() string {
+	if p.ReadReplicaHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s", p.User, p.Pwd, p.ReadReplicaHost, p.Port, p.DB)
+}
+
+// ServerConfig holds the HTTP server settings.
+type ServerConfig struct {
+	Port int `yaml:"port"`
+
+	// EnablePprof mounts net/http/pprof and an expvar runtime stats
+	// endpoint under /debug/, gated by admin authentication. Off by
+	// default; it exposes stack traces and memory contents.
+	EnablePprof bool `yaml:"enable_pprof"`
+}
+
+// LogConfig holds logging settings: the level (validated, not yet applied
+// as a filter) and where output goes, for logging.Setup.
+type LogConfig struct {
+	Level  string         `yaml:"level"`
+	Output logging.Output `yaml:"output"`
+
+	FilePath   string `yaml:"file_path"`
+	MaxSizeMB  int    `yaml:"max_size_mb"`
+	MaxBackups int    `yaml:"max_backups"`
+
+	SyslogAddr string `yaml:"syslog_addr"`
+
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+}
+
+// LoadConfig builds a Config for the given Kind from environment variables,
+// optionally overlaid with a YAML file. The file path is taken from
+// configPath if set, falling back to the CONFIG_FILE environment variable;
+// if neither is set, only the environment is consulted.
+func LoadConfig(kind Kind, configPath string) (*Config, error) {
+	cfg := &Config{
+		Kind: kind,
+		Postgres: PostgresConfig{
+			Host: "localhost",
+			Port: 5432,
+			User: "ntumiwa",
+			DB:   "ntumiwa",
+			Timeouts: TimeoutsConfig{
+				ReadSeconds:   3,
+				WriteSeconds:  6,
+				ReportSeconds: 9,
+			},
+		},
+		Server:         ServerConfig{Port: 8080},
+		Frontend:       FrontendConfig{APIBaseURL: "http://localhost:8080", Port: 8081},
+		Log:            LogConfig{Level: "info", Output: logging.OutputStdout},
+		RateLimit:      RateLimitConfig{RequestsPerSecond: 10, Burst: 20},
+		ActorRateLimit: ActorRateLimitConfig{Default: RateLimitConfig{RequestsPerSecond: 2, Burst: 10}},
+		Cache:          CacheConfig{PerformancesTTLSeconds: 60, PerformanceStatsTTLSeconds: 3600, BiographyTTLSeconds: 300},
+		Trash:          TrashConfig{RetentionDays: 30},
+		Cron: CronConfig{
+			PurgeSchedule:          "0 3 * * *",
+			EventReminderSchedule:  "0 8 * * *",
+			TicketStatusSchedule:   "*/15 * * * *",
+			CalendarImportSchedule: "*/30 * * * *",
+		},
+		Mailer: MailerConfig{
+			Kind:     mailer.KindSMTP,
+			From:     "no-reply@ntumiwa.example",
+			SMTPHost: "localhost",
+			SMTPPort: 25,
+		},
+		EventReminder: EventReminderConfig{LeadDays: 7},
+		Storage: StorageConfig{
+			Kind:           storage.KindLocal,
+			LocalDir:       "uploads",
+			LocalPublicURL: "http://localhost:8080/uploads",
+		},
+		GoogleCalendar: GoogleCalendarConfig{WorkerConcurrency: 1},
+		ErrorReporting: ErrorReportingConfig{Kind: errreport.KindNone, Environment: string(kind)},
+	}
+
+	if configPath == "" {
+		configPath = os.Getenv("CONFIG_FILE")
+	}
+	if configPath != "" {
+		if err := loadFile(configPath, cfg); err != nil {
+			return nil, fmt.Errorf("config: load file %s: %w", configPath, err)
+		}
+	}
+
+	// Environment variables take precedence over the file, so a deployment
+	// can override one setting without forking the whole file.
+	loadEnv(cfg)
+
+	if kind == KindAPI {
+		if err := cfg.resolveSecrets(); err != nil {
+			return nil, fmt.Errorf("config: resolve secrets: %w", err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// resolveSecrets fills in POSTGRES_PWD and SECRET_KEY from the configured
+// secrets provider when they weren't already set by the file or the
+// environment, so production doesn't have to keep them in a .env file.
+func (cfg *Config) resolveSecrets() error {
+	provider, err := secrets.New(cfg.Secrets.Kind, cfg.Secrets.FileDir, cfg.Secrets.VaultAddr)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if cfg.Postgres.Pwd == "" {
+		if v, err := provider.Get(ctx, "POSTGRES_PWD"); err == nil {
+			cfg.Postgres.Pwd = v
+		}
+	}
+	if cfg.SecretKey == "" {
+		if v, err := provider.Get(ctx, "SECRET_KEY"); err == nil {
+			cfg.SecretKey = v
+		}
+	}
+	if cfg.Mailer.SMTPPwd == "" {
+		if v, err := provider.Get(ctx, "MAILER_SMTP_PWD"); err == nil {
+			cfg.Mailer.SMTPPwd = v
+		}
+	}
+	if cfg.Mailer.APIKey == "" {
+		if v, err := provider.Get(ctx, "MAILER_API_KEY"); err == nil {
+			cfg.Mailer.APIKey = v
+		}
+	}
+	if cfg.ErrorReporting.DSN == "" {
+		if v, err := provider.Get(ctx, "ERROR_REPORTING_DSN"); err == nil {
+			cfg.ErrorReporting.DSN = v
+		}
+	}
+	if cfg.GoogleCalendar.Token == "" {
+		if v, err := provider.Get(ctx, "GOOGLE_CALENDAR_TOKEN"); err == nil {
+			cfg.GoogleCalendar.Token = v
+		}
+	}
+	if cfg.YouTube.APIKey == "" {
+		if v, err := provider.Get(ctx, "YOUTUBE_API_KEY"); err == nil {
+			cfg.YouTube.APIKey = v
+		}
+	}
+	if cfg.Newsletter.APIKey == "" {
+		if v, err := provider.Get(ctx, "NEWSLETTER_API_KEY"); err == nil {
+			cfg.Newsletter.APIKey = v
+		}
+	}
+	return nil
+}
+
+func loadFile(path string, cfg *Config) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(b, cfg)
+}
+
+func loadEnv(cfg *Config) {
+	if v := os.Getenv("POSTGRES_HOST"); v != "" {
+		cfg.Postgres.Host = v
+	}
+	if v := os.Getenv("POSTGRES_PORT"); v != "" {
+		if p, err := strconv.Atoi(v); err == nil {
+			cfg.Postgres.Port = p
+		}
+	}
+	if v := os.Getenv("POSTGRES_USER"); v != "" {
+		cfg.Postgres.User = v
+	}
+	if v := os.Getenv("POSTGRES_PWD"); v != "" {
+		cfg.Postgres.Pwd = v
+	}
+	if v := os.Getenv("POSTGRES_DB"); v != "" {
+		cfg.Postgres.DB = v
+	}
+	if v := os.Getenv("POSTGRES_READ_REPLICA_HOST"); v != "" {
+		cfg.Postgres.ReadReplicaHost = v
+	}
+	if v := os.Getenv("POSTGRES_READ_TIMEOUT_SECONDS"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			cfg.Postgres.Timeouts.ReadSeconds = s
+		}
+	}
+	if v := os.Getenv("POSTGRES_WRITE_TIMEOUT_SECONDS"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			cfg.Postgres.Timeouts.WriteSeconds = s
+		}
+	}
+	if v := os.Getenv("POSTGRES_REPORT_TIMEOUT_SECONDS"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil {
+			cfg.Postgres.Timeouts.ReportSeconds = s
+		}
+	}
+	if v := os.Getenv("PORT"); v != "" {
+		if p, err := strconv.Atoi(v); err == nil {
+			cfg.Server.Port = p
+			cfg.Frontend.Port = p
+		}
+	}
+	if v := os.Getenv("API_BASE_URL"); v != "" {
+		cfg.Frontend.APIBaseURL = v
+	}
+	if v := os.Getenv("SERVER_ENABLE_PPROF"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.Server.EnablePprof = b
+		}
+	}
+	if v := os.Getenv("LOG_LEVEL"); v != "" {
+		cfg.Log.Level = v
+	}
+	if v := os.Getenv("LOG_OUTPUT"); v != "" {
+		cfg.Log.Output = logging.Output(v)
+	}
+	if v := os.Getenv("LOG_FILE_PATH"); v != "" {
+		cfg.Log.FilePath = v
+	}
+	if v := os.Getenv("LOG_MAX_SIZE_MB"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Log.MaxSizeMB = n
+		}
+	}
+	if v := os.Getenv("LOG_MAX_BACKUPS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.Log.MaxBackups = n
+		}
+	}
+	if v := os.Getenv("LOG_SYSLOG_ADDR"); v != "" {
+		cfg.Log.SyslogAddr = v
+	}
+	if v := os.Getenv("LOG_OTLP_ENDPOINT"); v != "" {
+		cfg.Log.OTLPEndpoint = v
+	}
+	if v := os.Getenv("TRASH_RETENTION_DAYS"); v != "" {
+		if d, err := strconv.Atoi(v); err == nil {
+			cfg.Trash.RetentionDays = d
+		}
+	}
+	if v := os.Getenv("CRON_PURGE_SCHEDULE"); v != "" {
+		cfg.Cron.PurgeSchedule = v
+	}
+	if v := os.Getenv("CRON_EVENT_REMINDER_SCHEDULE"); v != "" {
+		cfg.Cron.EventReminderSchedule = v
+	}
+	if v := os.Getenv("CRON_TICKET_STATUS_SCHEDULE"); v != "" {
+		cfg.Cron.TicketStatusSchedule = v
+	}
+	if v := os.Getenv("EVENT_REMINDER_LEAD_DAYS"); v != "" {
+		if d, err := strconv.Atoi(v); err == nil {
+			cfg.EventReminder.LeadDays = d
+		}
+	}
+	if v := os.Getenv("EVENT_REMINDER_ADMIN_EMAIL"); v != "" {
+		cfg.EventReminder.AdminEmail = v
+	}
+	if v := os.Getenv("CRON_CALENDAR_IMPORT_SCHEDULE"); v != "" {
+		cfg.Cron.CalendarImportSchedule = v
+	}
+	if v := os.Getenv("CALENDAR_IMPORT_URL"); v != "" {
+		cfg.CalendarImport.URL = v
+	}
+	if v := os.Getenv("GOOGLE_CALENDAR_CALENDAR_ID"); v != "" {
+		cfg.GoogleCalendar.CalendarID = v
+	}
+	if v := os.Getenv("GOOGLE_CALENDAR_TOKEN"); v != "" {
+		cfg.GoogleCalendar.Token = v
+	}
+	if v := os.Getenv("GOOGLE_CALENDAR_WORKER_CONCURRENCY"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			cfg.GoogleCalendar.WorkerConcurrency = n
+		}
+	}
+	if v := os.Getenv("YOUTUBE_API_KEY"); v != "" {
+		cfg.YouTube.APIKey = v
+	}
+	if v := os.Getenv("NEWSLETTER_API_KEY"); v != "" {
+		cfg.Newsletter.APIKey = v
+	}
+	if v := os.Getenv("EVENT_FEED_ARTIST_NAME"); v != "" {
+		cfg.EventFeed.ArtistName = v
+	}
+	if v := os.Getenv("STORAGE_KIND"); v != "" {
+		cfg.Storage.Kind = storage.Kind(v)
+	}
+	if v := os.Getenv("STORAGE_LOCAL_DIR"); v != "" {
+		cfg.Storage.LocalDir = v
+	}
+	if v := os.Getenv("STORAGE_LOCAL_PUBLIC_URL"); v != "" {
+		cfg.Storage.LocalPublicURL = v
+	}
+	if v := os.Getenv("MAILER_KIND"); v != "" {
+		cfg.Mailer.Kind = mailer.Kind(v)
+	}
+	if v := os.Getenv("MAILER_FROM"); v != "" {
+		cfg.Mailer.From = v
+	}
+	if v := os.Getenv("MAILER_SMTP_HOST"); v != "" {
+		cfg.Mailer.SMTPHost = v
+	}
+	if v := os.Getenv("MAILER_SMTP_PORT"); v != "" {
+		if p, err := strconv.Atoi(v); err == nil {
+			cfg.Mailer.SMTPPort = p
+		}
+	}
+	if v := os.Getenv("MAILER_SMTP_USER"); v != "" {
+		cfg.Mailer.SMTPUser = v
+	}
+	if v := os.Getenv("MAILER_SMTP_PWD"); v != "" {
+		cfg.Mailer.SMTPPwd = v
+	}
+	if v := os.Getenv("MAILER_API_ENDPOINT"); v != "" {
+		cfg.Mailer.APIEndpoint = v
+	}
+	if v := os.Getenv("MAILER_API_KEY"); v != "" {
+		cfg.Mailer.APIKey = v
+	}
+	if v := os.Getenv("ERROR_REPORTING_KIND"); v != "" {
+		cfg.ErrorReporting.Kind = errreport.Kind(v)
+	}
+	if v := os.Getenv("ERROR_REPORTING_ENVIRONMENT"); v != "" {
+		cfg.ErrorReporting.Environment = v
+	}
+	if v := os.Getenv("ERROR_REPORTING_DSN"); v != "" {
+		cfg.ErrorReporting.DSN = v
+	}
+	if v := os.Getenv("DEBUG_LOG_ALLOWED_IPS"); v != "" {
+		cfg.DebugLog.AllowedIPs = strings.Split(v, ",")
+	}
+}
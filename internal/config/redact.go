@@ -0,0 +1,82 @@
+package config
+
+import "github.com/adamkadda/ntumiwa/internal/mailer"
+
+// Redacted is a copy of Config safe to print or serve: secret-bearing
+// fields are replaced with a fixed placeholder instead of being omitted,
+// so it's still obvious the field exists and where it's set from.
+type Redacted struct {
+	Kind           Kind                 `json:"kind"`
+	Postgres       RedactedPostgres     `json:"postgres"`
+	Server         ServerConfig         `json:"server"`
+	Frontend       FrontendConfig       `json:"frontend"`
+	Log            LogConfig            `json:"log"`
+	Secrets        SecretsConfig        `json:"secrets"`
+	RateLimit      RateLimitConfig      `json:"rate_limit"`
+	ActorRateLimit ActorRateLimitConfig `json:"actor_rate_limit"`
+	Cache          CacheConfig          `json:"cache"`
+	Mailer         RedactedMailer       `json:"mailer"`
+	SecretKey      string               `json:"secret_key"`
+}
+
+// RedactedPostgres mirrors PostgresConfig with the password blanked out.
+type RedactedPostgres struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+	User string `json:"user"`
+	Pwd  string `json:"pwd"`
+	DB   string `json:"db"`
+}
+
+// RedactedMailer mirrors MailerConfig with the SMTP password and API key
+// blanked out.
+type RedactedMailer struct {
+	Kind        mailer.Kind `json:"kind"`
+	From        string      `json:"from"`
+	SMTPHost    string      `json:"smtp_host"`
+	SMTPPort    int         `json:"smtp_port"`
+	SMTPUser    string      `json:"smtp_user"`
+	SMTPPwd     string      `json:"smtp_pwd"`
+	APIEndpoint string      `json:"api_endpoint"`
+	APIKey      string      `json:"api_key"`
+}
+
+const redactedPlaceholder = "[redacted]"
+
+// Redact returns a copy of cfg with SECRET_KEY, the Postgres password and
+// the mailer credentials replaced by a placeholder, suitable for
+// --print-config or an authenticated /admin/config endpoint.
+func (cfg *Config) Redact() Redacted {
+	r := Redacted{
+		Kind:           cfg.Kind,
+		Postgres:       RedactedPostgres{Host: cfg.Postgres.Host, Port: cfg.Postgres.Port, User: cfg.Postgres.User, DB: cfg.Postgres.DB},
+		Server:         cfg.Server,
+		Frontend:       cfg.Frontend,
+		Log:            cfg.Log,
+		Secrets:        cfg.Secrets,
+		RateLimit:      cfg.RateLimit,
+		ActorRateLimit: cfg.ActorRateLimit,
+		Cache:          cfg.Cache,
+		Mailer: RedactedMailer{
+			Kind:        cfg.Mailer.Kind,
+			From:        cfg.Mailer.From,
+			SMTPHost:    cfg.Mailer.SMTPHost,
+			SMTPPort:    cfg.Mailer.SMTPPort,
+			SMTPUser:    cfg.Mailer.SMTPUser,
+			APIEndpoint: cfg.Mailer.APIEndpoint,
+		},
+	}
+	if cfg.Postgres.Pwd != "" {
+		r.Postgres.Pwd = redactedPlaceholder
+	}
+	if cfg.SecretKey != "" {
+		r.SecretKey = redactedPlaceholder
+	}
+	if cfg.Mailer.SMTPPwd != "" {
+		r.Mailer.SMTPPwd = redactedPlaceholder
+	}
+	if cfg.Mailer.APIKey != "" {
+		r.Mailer.APIKey = redactedPlaceholder
+	}
+	return r
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// Store holds a Config that can be atomically swapped out at runtime, so
+// handlers reading it never observe a half-updated value.
+type Store struct {
+	kind       Kind
+	configPath string
+	v          atomic.Pointer[Config]
+}
+
+// NewStore wraps cfg (loaded from configPath) in a Store.
+func NewStore(kind Kind, configPath string, cfg *Config) *Store {
+	s := &Store{kind: kind, configPath: configPath}
+	s.v.Store(cfg)
+	return s
+}
+
+// Get returns the current Config. The returned value must be treated as
+// read-only; callers that need to mutate should Reload instead.
+func (s *Store) Get() *Config {
+	return s.v.Load()
+}
+
+// Reload re-runs LoadConfig and, if it succeeds, swaps it in. Settings that
+// aren't safe to change without a restart (e.g. the listen port) are
+// intentionally left alone by callers that only care about the reloadable
+// subset (log level, rate limits, cache TTLs).
+func (s *Store) Reload() (*Config, error) {
+	cfg, err := LoadConfig(s.kind, s.configPath)
+	if err != nil {
+		return nil, err
+	}
+	s.v.Store(cfg)
+	return cfg, nil
+}
+
+// WatchSIGHUP reloads the store whenever the process receives SIGHUP,
+// logging the outcome. It returns immediately; the watch runs in its own
+// goroutine for the life of the process.
+func (s *Store) WatchSIGHUP() {
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		for range sighup {
+			if _, err := s.Reload(); err != nil {
+				log.Printf("config: reload on SIGHUP failed: %v", err)
+				continue
+			}
+			log.Printf("config: reloaded on SIGHUP")
+		}
+	}()
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adamkadda/ntumiwa/internal/logging"
+)
+
+// ValidationError aggregates every problem found in a Config so operators
+// can fix them all in one pass instead of restart-fail-fix-restart per
+// missing variable.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("config: %d problem(s): %s", len(e.Problems), strings.Join(e.Problems, "; "))
+}
+
+// Validate checks cfg for missing or invalid required settings, returning a
+// *ValidationError listing every problem it finds, or nil if cfg is usable.
+func (cfg *Config) Validate() error {
+	var problems []string
+
+	switch cfg.Kind {
+	case KindAPI:
+		if cfg.Postgres.Host == "" {
+			problems = append(problems, "postgres.host (POSTGRES_HOST) is required")
+		}
+		if cfg.Postgres.Port <= 0 {
+			problems = append(problems, "postgres.port (POSTGRES_PORT) must be a positive integer")
+		}
+		if cfg.Postgres.User == "" {
+			problems = append(problems, "postgres.user (POSTGRES_USER) is required")
+		}
+		if cfg.Postgres.DB == "" {
+			problems = append(problems, "postgres.db (POSTGRES_DB) is required")
+		}
+		if cfg.Server.Port <= 0 || cfg.Server.Port > 65535 {
+			problems = append(problems, "server.port (PORT) must be between 1 and 65535")
+		}
+	case KindAdmin, KindPublic:
+		if cfg.Frontend.APIBaseURL == "" {
+			problems = append(problems, "frontend.api_base_url (API_BASE_URL) is required")
+		}
+		if cfg.Frontend.Port <= 0 || cfg.Frontend.Port > 65535 {
+			problems = append(problems, "frontend.port (PORT) must be between 1 and 65535")
+		}
+	}
+
+	switch cfg.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		problems = append(problems, fmt.Sprintf("log.level (LOG_LEVEL) %q is not one of debug/info/warn/error", cfg.Log.Level))
+	}
+
+	switch cfg.Log.Output {
+	case "", logging.OutputStdout, logging.OutputFile, logging.OutputSyslog, logging.OutputOTLP:
+	default:
+		problems = append(problems, fmt.Sprintf("log.output (LOG_OUTPUT) %q is not one of stdout/file/syslog/otlp", cfg.Log.Output))
+	}
+	if cfg.Log.Output == logging.OutputFile && cfg.Log.FilePath == "" {
+		problems = append(problems, "log.file_path (LOG_FILE_PATH) is required when log.output is \"file\"")
+	}
+	if cfg.Log.Output == logging.OutputOTLP && cfg.Log.OTLPEndpoint == "" {
+		problems = append(problems, "log.otlp_endpoint (LOG_OTLP_ENDPOINT) is required when log.output is \"otlp\"")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: problems}
+}
@@ -0,0 +1,100 @@
+// Package cron runs registered tasks on standard 5-field cron schedules,
+// coordinating across API instances with a Postgres advisory lock so a
+// schedule shared by every instance only executes once per due minute.
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fieldRange is the valid [min, max] for one of a cron expression's five
+// fields, in order: minute, hour, day-of-month, month, day-of-week.
+var fieldRanges = [5][2]int{
+	{0, 59}, // minute
+	{0, 23}, // hour
+	{1, 31}, // day of month
+	{1, 12}, // month
+	{0, 6},  // day of week (0 = Sunday, matching time.Weekday)
+}
+
+// Schedule is a parsed 5-field cron expression. Each field is a bitset of
+// the values that satisfy it.
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+}
+
+// Parse parses a standard 5-field cron expression ("minute hour dom month
+// dow"), e.g. "*/15 * * * *" or "0 3 * * 1-5". Each field supports *,
+// single values, ranges (1-5), lists (1,3,5) and steps (*/2, 1-10/2).
+func Parse(expr string) (Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return Schedule{}, fmt.Errorf("cron: expected 5 fields, got %d in %q", len(fields), expr)
+	}
+
+	var s Schedule
+	bits := [5]*uint64{&s.minute, &s.hour, &s.dom, &s.month, &s.dow}
+	for i, field := range fields {
+		b, err := parseField(field, fieldRanges[i][0], fieldRanges[i][1])
+		if err != nil {
+			return Schedule{}, fmt.Errorf("cron: field %d (%q): %w", i+1, field, err)
+		}
+		*bits[i] = b
+	}
+	return s, nil
+}
+
+// parseField turns one comma-separated cron field into a bitset of the
+// values in [min, max] it matches.
+func parseField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		base, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid step in %q", part)
+			}
+			base, step = part[:i], n
+		}
+
+		lo, hi := min, max
+		switch {
+		case base == "*":
+			// lo, hi already span the whole field.
+		case strings.Contains(base, "-"):
+			bounds := strings.SplitN(base, "-", 2)
+			l, err1 := strconv.Atoi(bounds[0])
+			h, err2 := strconv.Atoi(bounds[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid range in %q", part)
+			}
+			lo, hi = l, h
+		default:
+			v, err := strconv.Atoi(base)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value %q", part)
+			}
+			lo, hi = v, v
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("value out of range [%d,%d] in %q", min, max, part)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// matches reports whether t falls on a minute the schedule is due.
+func (s Schedule) matches(t time.Time) bool {
+	return s.minute&(1<<uint(t.Minute())) != 0 &&
+		s.hour&(1<<uint(t.Hour())) != 0 &&
+		s.dom&(1<<uint(t.Day())) != 0 &&
+		s.month&(1<<uint(t.Month())) != 0 &&
+		s.dow&(1<<uint(t.Weekday())) != 0
+}
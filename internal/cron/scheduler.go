@@ -0,0 +1,102 @@
+package cron
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"log"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+)
+
+// Task is one unit of work run on a cron schedule.
+type Task struct {
+	// Name identifies the task in logs and derives its advisory lock key,
+	// so it must be stable and unique across every registered task.
+	Name string
+	Expr string
+	Run  func(ctx context.Context) error
+}
+
+// Locker is the per-task mutual exclusion a Scheduler needs so that
+// multiple API instances sharing a schedule don't double-run a task in the
+// same due minute. *db.DB satisfies this via TryAdvisoryLock.
+type Locker interface {
+	TryAdvisoryLock(ctx context.Context, key int64) (*db.AdvisoryLock, bool, error)
+}
+
+// Scheduler runs registered Tasks on their cron schedules.
+type Scheduler struct {
+	Locker Locker
+
+	tasks []scheduledTask
+}
+
+type scheduledTask struct {
+	Task
+	schedule Schedule
+}
+
+// Register parses task.Expr and adds it to the scheduler. Call it for
+// every task before Run.
+func (s *Scheduler) Register(task Task) error {
+	schedule, err := Parse(task.Expr)
+	if err != nil {
+		return fmt.Errorf("cron: register %s: %w", task.Name, err)
+	}
+	s.tasks = append(s.tasks, scheduledTask{Task: task, schedule: schedule})
+	return nil
+}
+
+// Run checks every registered task once a minute until ctx is done. It's
+// meant to be started in its own goroutine and left running for the
+// process lifetime.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			for _, task := range s.tasks {
+				if task.schedule.matches(now) {
+					go s.runTask(ctx, task.Task)
+				}
+			}
+		}
+	}
+}
+
+// runTask acquires task's advisory lock and runs it. If another instance
+// already holds the lock for this due minute, runTask does nothing: that
+// instance is the one running it.
+func (s *Scheduler) runTask(ctx context.Context, task Task) {
+	lock, ok, err := s.Locker.TryAdvisoryLock(ctx, lockKey(task.Name))
+	if err != nil {
+		log.Printf("cron: %s: acquire lock: %v", task.Name, err)
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		if err := lock.Release(ctx); err != nil {
+			log.Printf("cron: %s: release lock: %v", task.Name, err)
+		}
+	}()
+
+	if err := task.Run(ctx); err != nil {
+		log.Printf("cron: %s: %v", task.Name, err)
+	}
+}
+
+// lockKey derives a stable advisory lock key from a task name so tasks
+// don't need to pick their own numeric ids.
+func lockKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
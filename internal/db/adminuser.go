@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// adminUserFromRow converts a generated sqlc row into the
+// models.AdminUser the CLI layer deals in.
+func adminUserFromRow(row sqlc.AdminUser) *models.AdminUser {
+	return &models.AdminUser{
+		ID:           row.ID,
+		Email:        row.Email,
+		PasswordHash: row.PasswordHash,
+		Active:       row.Active,
+		CreatedAt:    models.DateTime(row.CreatedAt),
+		UpdatedAt:    models.DateTime(row.UpdatedAt),
+	}
+}
+
+// CreateAdminUser hashes password and inserts a new active admin user
+// with the given email.
+func (d *DB) CreateAdminUser(ctx context.Context, email, password string) (*models.AdminUser, error) {
+	if len(email) < 1 {
+		return nil, fmt.Errorf("db: create admin user: email is required")
+	}
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("db: create admin user: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateAdminUser(ctx, sqlc.CreateAdminUserParams{
+		Email:        email,
+		PasswordHash: hash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create admin user: %w", err)
+	}
+	return adminUserFromRow(row), nil
+}
+
+// GetAdminUserByEmail returns the admin user with the given email.
+func (d *DB) GetAdminUserByEmail(ctx context.Context, email string) (*models.AdminUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetAdminUserByEmail(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("db: get admin user %q: %w", email, err)
+	}
+	return adminUserFromRow(row), nil
+}
+
+// SetAdminUserPassword hashes password and sets it as the given admin
+// user's new password.
+func (d *DB) SetAdminUserPassword(ctx context.Context, email, password string) (*models.AdminUser, error) {
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return nil, fmt.Errorf("db: set admin user password: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).SetAdminUserPassword(ctx, sqlc.SetAdminUserPasswordParams{
+		Email:        email,
+		PasswordHash: hash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: set admin user password for %q: %w", email, err)
+	}
+	return adminUserFromRow(row), nil
+}
+
+// SetAdminUserActive activates or deactivates the given admin user. A
+// deactivated user still exists (there's no delete path, since the point
+// is to revoke access without losing the audit trail attached to their
+// email) but should be refused at whatever authenticates against this
+// table once one exists.
+func (d *DB) SetAdminUserActive(ctx context.Context, email string, active bool) (*models.AdminUser, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).SetAdminUserActive(ctx, sqlc.SetAdminUserActiveParams{
+		Email:  email,
+		Active: active,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: set admin user active for %q: %w", email, err)
+	}
+	return adminUserFromRow(row), nil
+}
@@ -0,0 +1,858 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// archiveVersion is bumped whenever the Archive shape changes in a way that
+// would break Import against an older export.
+const archiveVersion = 1
+
+// Archive is a full, versioned snapshot of the catalogue: every composer,
+// venue, tour, season, programme, piece, event, ticket offer, media
+// attachment, guest collaborator, programme running order, debrief,
+// calendar hold, Google Calendar sync record, webhook channel, recording
+// and newsletter subscriber, including trashed rows, for backup and
+// environment cloning.
+type Archive struct {
+	Version                 int                               `json:"version"`
+	ExportedAt              time.Time                         `json:"exported_at"`
+	Composers               []models.Composer                 `json:"composers"`
+	Venues                  []models.Venue                    `json:"venues"`
+	Tours                   []models.Tour                     `json:"tours"`
+	Seasons                 []models.Season                   `json:"seasons"`
+	Programmes              []models.Programme                `json:"programmes"`
+	Pieces                  []models.Piece                    `json:"pieces"`
+	Recordings              []models.Recording                `json:"recordings"`
+	Events                  []models.Event                    `json:"events"`
+	TicketOffers            []ArchivedTicketOffer             `json:"ticket_offers"`
+	EventMedia              []ArchivedEventMedia              `json:"event_media"`
+	VenueMedia              []ArchivedVenueMedia              `json:"venue_media"`
+	RecordingStreamingLinks []ArchivedRecordingStreamingLink  `json:"recording_streaming_links"`
+	Collaborators           []ArchivedEventCollaborator       `json:"event_collaborators"`
+	ProgrammePieces         []ArchivedProgrammePiece          `json:"programme_pieces"`
+	EventDebriefs           []models.EventDebrief             `json:"event_debriefs"`
+	CalendarHolds           []models.CalendarHold             `json:"calendar_holds"`
+	GoogleCalendarSyncs     []models.GoogleCalendarSync       `json:"google_calendar_syncs"`
+	WebhookChannels         []models.WebhookChannel           `json:"webhook_channels"`
+	Subscribers             []models.Subscriber               `json:"subscribers"`
+}
+
+// ArchivedTicketOffer is one row of event_ticket_offers, carrying the
+// parent event id since, unlike models.TicketOffer as served over the API,
+// an archived offer isn't already nested under its event.
+type ArchivedTicketOffer struct {
+	models.TicketOffer
+	EventID int64 `json:"event_id"`
+}
+
+// ArchivedEventMedia is one row of event_media, carrying the parent event
+// id for the same reason as ArchivedTicketOffer.
+type ArchivedEventMedia struct {
+	models.EventMedia
+	EventID int64 `json:"event_id"`
+}
+
+// ArchivedVenueMedia is one row of venue_media, carrying the parent venue
+// id for the same reason as ArchivedTicketOffer.
+type ArchivedVenueMedia struct {
+	models.VenueMedia
+	VenueID int64 `json:"venue_id"`
+}
+
+// ArchivedRecordingStreamingLink is one row of recording_streaming_links,
+// carrying the parent recording id for the same reason as
+// ArchivedTicketOffer.
+type ArchivedRecordingStreamingLink struct {
+	models.RecordingStreamingLink
+	RecordingID int64 `json:"recording_id"`
+}
+
+// ArchivedEventCollaborator is one row of event_collaborators, carrying
+// the parent event id for the same reason as ArchivedTicketOffer.
+type ArchivedEventCollaborator struct {
+	models.EventCollaborator
+	EventID int64 `json:"event_id"`
+}
+
+// ArchivedProgrammePiece is one row of programme_pieces, carrying the
+// parent programme id for the same reason as ArchivedTicketOffer.
+type ArchivedProgrammePiece struct {
+	models.ProgrammePiece
+	ProgrammeID int64 `json:"programme_id"`
+}
+
+// Export builds an Archive of the entire catalogue. It uses the Report
+// timeout class since, unlike a single-row Get, it scans every row in every
+// table.
+func (d *DB) Export(ctx context.Context) (*Archive, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	archive := &Archive{Version: archiveVersion, ExportedAt: time.Now()}
+
+	composerRows, err := d.pool.Query(ctx, `SELECT id, name, created_at, updated_at, deleted_at FROM composers`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export composers: %w", err)
+	}
+	archive.Composers, err = pgx.CollectRows(composerRows, pgx.RowToStructByName[models.Composer])
+	if err != nil {
+		return nil, fmt.Errorf("db: export composers: %w", err)
+	}
+
+	venueRows, err := d.pool.Query(ctx, `SELECT id, name, city, country, description, created_by, updated_by, created_at, updated_at, deleted_at FROM venues`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export venues: %w", err)
+	}
+	archive.Venues, err = pgx.CollectRows(venueRows, pgx.RowToStructByName[models.Venue])
+	if err != nil {
+		return nil, fmt.Errorf("db: export venues: %w", err)
+	}
+
+	tourRows, err := d.pool.Query(ctx, `SELECT id, name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at FROM tours`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export tours: %w", err)
+	}
+	archive.Tours, err = pgx.CollectRows(tourRows, pgx.RowToStructByName[models.Tour])
+	if err != nil {
+		return nil, fmt.Errorf("db: export tours: %w", err)
+	}
+
+	seasonRows, err := d.pool.Query(ctx, `SELECT id, name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at FROM seasons`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export seasons: %w", err)
+	}
+	archive.Seasons, err = pgx.CollectRows(seasonRows, pgx.RowToStructByName[models.Season])
+	if err != nil {
+		return nil, fmt.Errorf("db: export seasons: %w", err)
+	}
+
+	programmeRows, err := d.pool.Query(ctx, `SELECT id, title, created_by, updated_by, created_at, updated_at, deleted_at FROM programmes`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export programmes: %w", err)
+	}
+	archive.Programmes, err = pgx.CollectRows(programmeRows, pgx.RowToStructByName[models.Programme])
+	if err != nil {
+		return nil, fmt.Errorf("db: export programmes: %w", err)
+	}
+
+	pieceRows, err := d.pool.Query(ctx, `SELECT id, title, composer_id, created_by, updated_by, created_at, updated_at, deleted_at FROM pieces`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export pieces: %w", err)
+	}
+	archive.Pieces, err = pgx.CollectRows(pieceRows, pgx.RowToStructByName[models.Piece])
+	if err != nil {
+		return nil, fmt.Errorf("db: export pieces: %w", err)
+	}
+
+	recordingRows, err := d.pool.Query(ctx, `SELECT id, title, piece_id, release_date, created_by, updated_by, created_at, updated_at, deleted_at FROM recordings`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export recordings: %w", err)
+	}
+	archive.Recordings, err = pgx.CollectRows(recordingRows, pgx.RowToStructByName[models.Recording])
+	if err != nil {
+		return nil, fmt.Errorf("db: export recordings: %w", err)
+	}
+
+	eventRows, err := d.pool.Query(ctx, `
+		SELECT id, slug, title, status, event_date, venue_id, programme_id,
+		       notes, created_by, updated_by, created_at, updated_at, deleted_at, visibility, tour_id, season_id
+		FROM events`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export events: %w", err)
+	}
+	archive.Events, err = pgx.CollectRows(eventRows, pgx.RowToStructByName[models.Event])
+	if err != nil {
+		return nil, fmt.Errorf("db: export events: %w", err)
+	}
+
+	offerRows, err := d.pool.Query(ctx, `
+		SELECT id, event_id, label, url, price_cents, currency, status, created_at, updated_at
+		FROM event_ticket_offers`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export ticket offers: %w", err)
+	}
+	archive.TicketOffers, err = pgx.CollectRows(offerRows, pgx.RowToStructByName[ArchivedTicketOffer])
+	if err != nil {
+		return nil, fmt.Errorf("db: export ticket offers: %w", err)
+	}
+
+	mediaRows, err := d.pool.Query(ctx, `
+		SELECT id, event_id, kind, url, label, title, duration_seconds, thumbnail_url, metadata_fetched_at, created_at, updated_at
+		FROM event_media`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export event media: %w", err)
+	}
+	archive.EventMedia, err = pgx.CollectRows(mediaRows, pgx.RowToStructByName[ArchivedEventMedia])
+	if err != nil {
+		return nil, fmt.Errorf("db: export event media: %w", err)
+	}
+
+	venueMediaRows, err := d.pool.Query(ctx, `
+		SELECT id, venue_id, url, label, created_at, updated_at
+		FROM venue_media`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export venue media: %w", err)
+	}
+	archive.VenueMedia, err = pgx.CollectRows(venueMediaRows, pgx.RowToStructByName[ArchivedVenueMedia])
+	if err != nil {
+		return nil, fmt.Errorf("db: export venue media: %w", err)
+	}
+
+	streamingLinkRows, err := d.pool.Query(ctx, `
+		SELECT id, recording_id, provider, url, artwork_url, provider_release_date, metadata_fetched_at, created_at, updated_at
+		FROM recording_streaming_links`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export recording streaming links: %w", err)
+	}
+	archive.RecordingStreamingLinks, err = pgx.CollectRows(streamingLinkRows, pgx.RowToStructByName[ArchivedRecordingStreamingLink])
+	if err != nil {
+		return nil, fmt.Errorf("db: export recording streaming links: %w", err)
+	}
+
+	collaboratorRows, err := d.pool.Query(ctx, `
+		SELECT id, event_id, name, role, created_at, updated_at
+		FROM event_collaborators`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export event collaborators: %w", err)
+	}
+	archive.Collaborators, err = pgx.CollectRows(collaboratorRows, pgx.RowToStructByName[ArchivedEventCollaborator])
+	if err != nil {
+		return nil, fmt.Errorf("db: export event collaborators: %w", err)
+	}
+
+	programmePieceRows, err := d.pool.Query(ctx, `
+		SELECT id, programme_id, piece_id, position, notes, created_at, updated_at
+		FROM programme_pieces`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export programme pieces: %w", err)
+	}
+	archive.ProgrammePieces, err = pgx.CollectRows(programmePieceRows, pgx.RowToStructByName[ArchivedProgrammePiece])
+	if err != nil {
+		return nil, fmt.Errorf("db: export programme pieces: %w", err)
+	}
+
+	debriefRows, err := d.pool.Query(ctx, `
+		SELECT event_id, actual_attendance, notes, setlist_deviations, updated_by, updated_at
+		FROM event_debriefs`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export event debriefs: %w", err)
+	}
+	archive.EventDebriefs, err = pgx.CollectRows(debriefRows, pgx.RowToStructByName[models.EventDebrief])
+	if err != nil {
+		return nil, fmt.Errorf("db: export event debriefs: %w", err)
+	}
+
+	holdRows, err := d.pool.Query(ctx, `
+		SELECT id, external_uid, source_url, event_id, summary, starts_at, synced_at, created_at
+		FROM calendar_holds`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export calendar holds: %w", err)
+	}
+	archive.CalendarHolds, err = pgx.CollectRows(holdRows, pgx.RowToStructByName[models.CalendarHold])
+	if err != nil {
+		return nil, fmt.Errorf("db: export calendar holds: %w", err)
+	}
+
+	syncRows, err := d.pool.Query(ctx, `
+		SELECT event_id, google_event_id, synced_at
+		FROM google_calendar_syncs`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export google calendar syncs: %w", err)
+	}
+	archive.GoogleCalendarSyncs, err = pgx.CollectRows(syncRows, pgx.RowToStructByName[models.GoogleCalendarSync])
+	if err != nil {
+		return nil, fmt.Errorf("db: export google calendar syncs: %w", err)
+	}
+
+	channelRows, err := d.pool.Query(ctx, `
+		SELECT id, name, url, payload_mode, text_template, image_url_template, link_template, created_by, updated_by, created_at, updated_at, deleted_at
+		FROM webhook_channels`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export webhook channels: %w", err)
+	}
+	archive.WebhookChannels, err = pgx.CollectRows(channelRows, pgx.RowToStructByName[models.WebhookChannel])
+	if err != nil {
+		return nil, fmt.Errorf("db: export webhook channels: %w", err)
+	}
+
+	subscriberRows, err := d.pool.Query(ctx, `
+		SELECT id, email, created_at
+		FROM subscribers`)
+	if err != nil {
+		return nil, fmt.Errorf("db: export subscribers: %w", err)
+	}
+	archive.Subscribers, err = pgx.CollectRows(subscriberRows, pgx.RowToStructByName[models.Subscriber])
+	if err != nil {
+		return nil, fmt.Errorf("db: export subscribers: %w", err)
+	}
+
+	return archive, nil
+}
+
+// Import restores an Archive, upserting each row by id and leaving anything
+// already present but absent from the archive untouched. Resources are
+// imported in foreign-key order (composers, venues, tours and seasons
+// before the pieces, programmes and events that reference them) inside a
+// single transaction, so a partial failure doesn't leave the catalogue
+// half-restored.
+func (d *DB) Import(ctx context.Context, archive *Archive) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		for _, c := range archive.Composers {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO composers (id, name, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5)
+				ON CONFLICT (id) DO UPDATE SET
+					name = EXCLUDED.name, updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				c.ID, c.Name, c.CreatedAt, c.UpdatedAt, c.DeletedAt); err != nil {
+				return fmt.Errorf("import composer %d: %w", c.ID, err)
+			}
+		}
+
+		for _, v := range archive.Venues {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO venues (id, name, city, country, description, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+				ON CONFLICT (id) DO UPDATE SET
+					name = EXCLUDED.name, city = EXCLUDED.city, country = EXCLUDED.country, description = EXCLUDED.description,
+					updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				v.ID, v.Name, v.City, v.Country, v.Description, v.CreatedBy, v.UpdatedBy, v.CreatedAt, v.UpdatedAt, v.DeletedAt); err != nil {
+				return fmt.Errorf("import venue %d: %w", v.ID, err)
+			}
+		}
+
+		for _, t := range archive.Tours {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO tours (id, name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (id) DO UPDATE SET
+					name = EXCLUDED.name, starts_on = EXCLUDED.starts_on, ends_on = EXCLUDED.ends_on,
+					updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				t.ID, t.Name, t.StartsOn, t.EndsOn, t.CreatedBy, t.UpdatedBy, t.CreatedAt, t.UpdatedAt, t.DeletedAt); err != nil {
+				return fmt.Errorf("import tour %d: %w", t.ID, err)
+			}
+		}
+
+		for _, s := range archive.Seasons {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO seasons (id, name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (id) DO UPDATE SET
+					name = EXCLUDED.name, starts_on = EXCLUDED.starts_on, ends_on = EXCLUDED.ends_on,
+					updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				s.ID, s.Name, s.StartsOn, s.EndsOn, s.CreatedBy, s.UpdatedBy, s.CreatedAt, s.UpdatedAt, s.DeletedAt); err != nil {
+				return fmt.Errorf("import season %d: %w", s.ID, err)
+			}
+		}
+
+		for _, p := range archive.Programmes {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO programmes (id, title, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				ON CONFLICT (id) DO UPDATE SET
+					title = EXCLUDED.title, updated_by = EXCLUDED.updated_by,
+					updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				p.ID, p.Title, p.CreatedBy, p.UpdatedBy, p.CreatedAt, p.UpdatedAt, p.DeletedAt); err != nil {
+				return fmt.Errorf("import programme %d: %w", p.ID, err)
+			}
+		}
+
+		for _, p := range archive.Pieces {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO pieces (id, title, composer_id, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT (id) DO UPDATE SET
+					title = EXCLUDED.title, composer_id = EXCLUDED.composer_id, updated_by = EXCLUDED.updated_by,
+					updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				p.ID, p.Title, p.ComposerID, p.CreatedBy, p.UpdatedBy, p.CreatedAt, p.UpdatedAt, p.DeletedAt); err != nil {
+				return fmt.Errorf("import piece %d: %w", p.ID, err)
+			}
+		}
+
+		for _, r := range archive.Recordings {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO recordings (id, title, piece_id, release_date, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (id) DO UPDATE SET
+					title = EXCLUDED.title, piece_id = EXCLUDED.piece_id, release_date = EXCLUDED.release_date,
+					updated_by = EXCLUDED.updated_by, updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				r.ID, r.Title, r.PieceID, r.ReleaseDate, r.CreatedBy, r.UpdatedBy, r.CreatedAt, r.UpdatedAt, r.DeletedAt); err != nil {
+				return fmt.Errorf("import recording %d: %w", r.ID, err)
+			}
+		}
+
+		for _, l := range archive.RecordingStreamingLinks {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO recording_streaming_links (id, recording_id, provider, url, artwork_url, provider_release_date, metadata_fetched_at, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (id) DO UPDATE SET
+					recording_id = EXCLUDED.recording_id, provider = EXCLUDED.provider, url = EXCLUDED.url,
+					artwork_url = EXCLUDED.artwork_url, provider_release_date = EXCLUDED.provider_release_date,
+					metadata_fetched_at = EXCLUDED.metadata_fetched_at, updated_at = EXCLUDED.updated_at`,
+				l.ID, l.RecordingID, l.Provider, l.URL, l.ArtworkURL, l.ProviderReleaseDate, l.MetadataFetchedAt, l.CreatedAt, l.UpdatedAt); err != nil {
+				return fmt.Errorf("import recording streaming link %d: %w", l.ID, err)
+			}
+		}
+
+		for _, e := range archive.Events {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO events (id, slug, title, status, event_date, venue_id, programme_id,
+				                     notes, created_by, updated_by, created_at, updated_at, deleted_at, visibility, tour_id, season_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+				ON CONFLICT (id) DO UPDATE SET
+					slug = EXCLUDED.slug, title = EXCLUDED.title, status = EXCLUDED.status,
+					event_date = EXCLUDED.event_date, venue_id = EXCLUDED.venue_id, programme_id = EXCLUDED.programme_id,
+					notes = EXCLUDED.notes, updated_by = EXCLUDED.updated_by,
+					updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at, visibility = EXCLUDED.visibility,
+					tour_id = EXCLUDED.tour_id, season_id = EXCLUDED.season_id`,
+				e.ID, e.Slug, e.Title, e.Status, e.EventDate, e.VenueID, e.ProgrammeID,
+				e.Notes, e.CreatedBy, e.UpdatedBy, e.CreatedAt, e.UpdatedAt, e.DeletedAt, e.Visibility, e.TourID, e.SeasonID); err != nil {
+				return fmt.Errorf("import event %d: %w", e.ID, err)
+			}
+		}
+
+		for _, o := range archive.TicketOffers {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_ticket_offers (id, event_id, label, url, price_cents, currency, status, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+				ON CONFLICT (id) DO UPDATE SET
+					event_id = EXCLUDED.event_id, label = EXCLUDED.label, url = EXCLUDED.url,
+					price_cents = EXCLUDED.price_cents, currency = EXCLUDED.currency, status = EXCLUDED.status,
+					updated_at = EXCLUDED.updated_at`,
+				o.ID, o.EventID, o.Label, o.URL, o.PriceCents, o.Currency, o.Status, o.CreatedAt, o.UpdatedAt); err != nil {
+				return fmt.Errorf("import ticket offer %d: %w", o.ID, err)
+			}
+		}
+
+		for _, m := range archive.EventMedia {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_media (id, event_id, kind, url, label, title, duration_seconds, thumbnail_url, metadata_fetched_at, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+				ON CONFLICT (id) DO UPDATE SET
+					event_id = EXCLUDED.event_id, kind = EXCLUDED.kind, url = EXCLUDED.url,
+					label = EXCLUDED.label, title = EXCLUDED.title,
+					duration_seconds = EXCLUDED.duration_seconds, thumbnail_url = EXCLUDED.thumbnail_url,
+					metadata_fetched_at = EXCLUDED.metadata_fetched_at, updated_at = EXCLUDED.updated_at`,
+				m.ID, m.EventID, m.Kind, m.URL, m.Label, m.Title, m.DurationSeconds, m.ThumbnailURL, m.MetadataFetchedAt, m.CreatedAt, m.UpdatedAt); err != nil {
+				return fmt.Errorf("import event media %d: %w", m.ID, err)
+			}
+		}
+
+		for _, m := range archive.VenueMedia {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO venue_media (id, venue_id, url, label, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (id) DO UPDATE SET
+					venue_id = EXCLUDED.venue_id, url = EXCLUDED.url,
+					label = EXCLUDED.label, updated_at = EXCLUDED.updated_at`,
+				m.ID, m.VenueID, m.URL, m.Label, m.CreatedAt, m.UpdatedAt); err != nil {
+				return fmt.Errorf("import venue media %d: %w", m.ID, err)
+			}
+		}
+
+		for _, c := range archive.Collaborators {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_collaborators (id, event_id, name, role, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (id) DO UPDATE SET
+					event_id = EXCLUDED.event_id, name = EXCLUDED.name,
+					role = EXCLUDED.role, updated_at = EXCLUDED.updated_at`,
+				c.ID, c.EventID, c.Name, c.Role, c.CreatedAt, c.UpdatedAt); err != nil {
+				return fmt.Errorf("import event collaborator %d: %w", c.ID, err)
+			}
+		}
+
+		for _, p := range archive.ProgrammePieces {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO programme_pieces (id, programme_id, piece_id, position, notes, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)
+				ON CONFLICT (id) DO UPDATE SET
+					programme_id = EXCLUDED.programme_id, piece_id = EXCLUDED.piece_id,
+					position = EXCLUDED.position, notes = EXCLUDED.notes, updated_at = EXCLUDED.updated_at`,
+				p.ID, p.ProgrammeID, p.PieceID, p.Position, p.Notes, p.CreatedAt, p.UpdatedAt); err != nil {
+				return fmt.Errorf("import programme piece %d: %w", p.ID, err)
+			}
+		}
+
+		for _, deb := range archive.EventDebriefs {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_debriefs (event_id, actual_attendance, notes, setlist_deviations, updated_by, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)
+				ON CONFLICT (event_id) DO UPDATE SET
+					actual_attendance = EXCLUDED.actual_attendance, notes = EXCLUDED.notes,
+					setlist_deviations = EXCLUDED.setlist_deviations, updated_by = EXCLUDED.updated_by,
+					updated_at = EXCLUDED.updated_at`,
+				deb.EventID, deb.ActualAttendance, deb.Notes, deb.SetlistDeviations, deb.UpdatedBy, deb.UpdatedAt); err != nil {
+				return fmt.Errorf("import event debrief %d: %w", deb.EventID, err)
+			}
+		}
+
+		for _, h := range archive.CalendarHolds {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO calendar_holds (id, external_uid, source_url, event_id, summary, starts_at, synced_at, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				ON CONFLICT (id) DO UPDATE SET
+					external_uid = EXCLUDED.external_uid, source_url = EXCLUDED.source_url,
+					event_id = EXCLUDED.event_id, summary = EXCLUDED.summary,
+					starts_at = EXCLUDED.starts_at, synced_at = EXCLUDED.synced_at`,
+				h.ID, h.ExternalUID, h.SourceURL, h.EventID, h.Summary, h.StartsAt, h.SyncedAt, h.CreatedAt); err != nil {
+				return fmt.Errorf("import calendar hold %d: %w", h.ID, err)
+			}
+		}
+
+		for _, s := range archive.GoogleCalendarSyncs {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO google_calendar_syncs (event_id, google_event_id, synced_at)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (event_id) DO UPDATE SET
+					google_event_id = EXCLUDED.google_event_id, synced_at = EXCLUDED.synced_at`,
+				s.EventID, s.GoogleEventID, s.SyncedAt); err != nil {
+				return fmt.Errorf("import google calendar sync %d: %w", s.EventID, err)
+			}
+		}
+
+		for _, c := range archive.WebhookChannels {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO webhook_channels (id, name, url, payload_mode, text_template, image_url_template, link_template, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+				ON CONFLICT (id) DO UPDATE SET
+					name = EXCLUDED.name, url = EXCLUDED.url, payload_mode = EXCLUDED.payload_mode,
+					text_template = EXCLUDED.text_template, image_url_template = EXCLUDED.image_url_template,
+					link_template = EXCLUDED.link_template, updated_by = EXCLUDED.updated_by,
+					updated_at = EXCLUDED.updated_at, deleted_at = EXCLUDED.deleted_at`,
+				c.ID, c.Name, c.URL, c.PayloadMode, c.TextTemplate, c.ImageURLTemplate, c.LinkTemplate, c.CreatedBy, c.UpdatedBy, c.CreatedAt, c.UpdatedAt, c.DeletedAt); err != nil {
+				return fmt.Errorf("import webhook channel %d: %w", c.ID, err)
+			}
+		}
+
+		for _, s := range archive.Subscribers {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO subscribers (id, email, created_at)
+				VALUES ($1, $2, $3)
+				ON CONFLICT (id) DO UPDATE SET email = EXCLUDED.email`,
+				s.ID, s.Email, s.CreatedAt); err != nil {
+				return fmt.Errorf("import subscriber %d: %w", s.ID, err)
+			}
+		}
+
+		for _, table := range []string{"composers", "venues", "tours", "seasons", "programmes", "pieces", "recordings", "events", "event_ticket_offers", "event_media", "venue_media", "recording_streaming_links", "event_collaborators", "programme_pieces", "calendar_holds", "webhook_channels", "subscribers"} {
+			if _, err := tx.Exec(ctx, fmt.Sprintf(
+				`SELECT setval(pg_get_serial_sequence('%s', 'id'), COALESCE((SELECT MAX(id) FROM %s), 1))`, table, table)); err != nil {
+				return fmt.Errorf("resync %s id sequence: %w", table, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("db: import archive: %w", err)
+	}
+	return nil
+}
+
+// ImportRemapped restores an Archive the same way Import does, except every
+// row is inserted fresh with a database-assigned id instead of upserting by
+// its original one. It's for importing into an environment that already
+// has its own catalogue with overlapping ids, e.g. a staging refresh or a
+// migration from a previous system, where reusing the source ids would
+// either collide with existing rows or silently overwrite them. Foreign
+// keys are rewritten in memory as each referenced table is inserted, so
+// callers get back a coherent, freshly-numbered copy of the archive.
+func (d *DB) ImportRemapped(ctx context.Context, archive *Archive) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		composerIDs := make(map[int64]int64, len(archive.Composers))
+		venueIDs := make(map[int64]int64, len(archive.Venues))
+		tourIDs := make(map[int64]int64, len(archive.Tours))
+		seasonIDs := make(map[int64]int64, len(archive.Seasons))
+		programmeIDs := make(map[int64]int64, len(archive.Programmes))
+		pieceIDs := make(map[int64]int64, len(archive.Pieces))
+		recordingIDs := make(map[int64]int64, len(archive.Recordings))
+		eventIDs := make(map[int64]int64, len(archive.Events))
+
+		for _, c := range archive.Composers {
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO composers (name, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4) RETURNING id`,
+				c.Name, c.CreatedAt, c.UpdatedAt, c.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import composer %q: %w", c.Name, err)
+			}
+			composerIDs[c.ID] = newID
+		}
+
+		for _, v := range archive.Venues {
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO venues (name, city, country, description, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) RETURNING id`,
+				v.Name, v.City, v.Country, v.Description, v.CreatedBy, v.UpdatedBy, v.CreatedAt, v.UpdatedAt, v.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import venue %q: %w", v.Name, err)
+			}
+			venueIDs[v.ID] = newID
+		}
+
+		for _, t := range archive.Tours {
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO tours (name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+				t.Name, t.StartsOn, t.EndsOn, t.CreatedBy, t.UpdatedBy, t.CreatedAt, t.UpdatedAt, t.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import tour %q: %w", t.Name, err)
+			}
+			tourIDs[t.ID] = newID
+		}
+
+		for _, s := range archive.Seasons {
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO seasons (name, starts_on, ends_on, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+				s.Name, s.StartsOn, s.EndsOn, s.CreatedBy, s.UpdatedBy, s.CreatedAt, s.UpdatedAt, s.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import season %q: %w", s.Name, err)
+			}
+			seasonIDs[s.ID] = newID
+		}
+
+		for _, p := range archive.Programmes {
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO programmes (title, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+				p.Title, p.CreatedBy, p.UpdatedBy, p.CreatedAt, p.UpdatedAt, p.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import programme %q: %w", p.Title, err)
+			}
+			programmeIDs[p.ID] = newID
+		}
+
+		for _, p := range archive.Pieces {
+			composerID, ok := composerIDs[p.ComposerID]
+			if !ok {
+				return fmt.Errorf("import piece %q: unknown composer id %d", p.Title, p.ComposerID)
+			}
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO pieces (title, composer_id, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+				p.Title, composerID, p.CreatedBy, p.UpdatedBy, p.CreatedAt, p.UpdatedAt, p.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import piece %q: %w", p.Title, err)
+			}
+			pieceIDs[p.ID] = newID
+		}
+
+		for _, r := range archive.Recordings {
+			pieceID, ok := pieceIDs[r.PieceID]
+			if !ok {
+				return fmt.Errorf("import recording %q: unknown piece id %d", r.Title, r.PieceID)
+			}
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO recordings (title, piece_id, release_date, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`,
+				r.Title, pieceID, r.ReleaseDate, r.CreatedBy, r.UpdatedBy, r.CreatedAt, r.UpdatedAt, r.DeletedAt).Scan(&newID); err != nil {
+				return fmt.Errorf("import recording %q: %w", r.Title, err)
+			}
+			recordingIDs[r.ID] = newID
+		}
+
+		for _, l := range archive.RecordingStreamingLinks {
+			recordingID, ok := recordingIDs[l.RecordingID]
+			if !ok {
+				return fmt.Errorf("import recording streaming link %q: unknown recording id %d", l.URL, l.RecordingID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO recording_streaming_links (recording_id, provider, url, artwork_url, provider_release_date, metadata_fetched_at, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+				recordingID, l.Provider, l.URL, l.ArtworkURL, l.ProviderReleaseDate, l.MetadataFetchedAt, l.CreatedAt, l.UpdatedAt); err != nil {
+				return fmt.Errorf("import recording streaming link %q: %w", l.URL, err)
+			}
+		}
+
+		for _, e := range archive.Events {
+			venueID, err := remapOptionalID(venueIDs, e.VenueID)
+			if err != nil {
+				return fmt.Errorf("import event %q: venue: %w", e.Slug, err)
+			}
+			programmeID, err := remapOptionalID(programmeIDs, e.ProgrammeID)
+			if err != nil {
+				return fmt.Errorf("import event %q: programme: %w", e.Slug, err)
+			}
+			tourID, err := remapOptionalID(tourIDs, e.TourID)
+			if err != nil {
+				return fmt.Errorf("import event %q: tour: %w", e.Slug, err)
+			}
+			seasonID, err := remapOptionalID(seasonIDs, e.SeasonID)
+			if err != nil {
+				return fmt.Errorf("import event %q: season: %w", e.Slug, err)
+			}
+
+			var newID int64
+			if err := tx.QueryRow(ctx, `
+				INSERT INTO events (slug, title, status, event_date, venue_id, programme_id,
+				                     notes, created_by, updated_by, created_at, updated_at, deleted_at, visibility, tour_id, season_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15) RETURNING id`,
+				e.Slug, e.Title, e.Status, e.EventDate, venueID, programmeID,
+				e.Notes, e.CreatedBy, e.UpdatedBy, e.CreatedAt, e.UpdatedAt, e.DeletedAt, e.Visibility, tourID, seasonID).Scan(&newID); err != nil {
+				return fmt.Errorf("import event %q: %w", e.Slug, err)
+			}
+			eventIDs[e.ID] = newID
+		}
+
+		for _, o := range archive.TicketOffers {
+			eventID, ok := eventIDs[o.EventID]
+			if !ok {
+				return fmt.Errorf("import ticket offer %q: unknown event id %d", o.URL, o.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_ticket_offers (event_id, label, url, price_cents, currency, status, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+				eventID, o.Label, o.URL, o.PriceCents, o.Currency, o.Status, o.CreatedAt, o.UpdatedAt); err != nil {
+				return fmt.Errorf("import ticket offer %q: %w", o.URL, err)
+			}
+		}
+
+		for _, m := range archive.EventMedia {
+			eventID, ok := eventIDs[m.EventID]
+			if !ok {
+				return fmt.Errorf("import event media %q: unknown event id %d", m.URL, m.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_media (event_id, kind, url, label, title, duration_seconds, thumbnail_url, metadata_fetched_at, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+				eventID, m.Kind, m.URL, m.Label, m.Title, m.DurationSeconds, m.ThumbnailURL, m.MetadataFetchedAt, m.CreatedAt, m.UpdatedAt); err != nil {
+				return fmt.Errorf("import event media %q: %w", m.URL, err)
+			}
+		}
+
+		for _, m := range archive.VenueMedia {
+			venueID, ok := venueIDs[m.VenueID]
+			if !ok {
+				return fmt.Errorf("import venue media %q: unknown venue id %d", m.URL, m.VenueID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO venue_media (venue_id, url, label, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5)`,
+				venueID, m.URL, m.Label, m.CreatedAt, m.UpdatedAt); err != nil {
+				return fmt.Errorf("import venue media %q: %w", m.URL, err)
+			}
+		}
+
+		for _, c := range archive.Collaborators {
+			eventID, ok := eventIDs[c.EventID]
+			if !ok {
+				return fmt.Errorf("import event collaborator %q: unknown event id %d", c.Name, c.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_collaborators (event_id, name, role, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5)`,
+				eventID, c.Name, c.Role, c.CreatedAt, c.UpdatedAt); err != nil {
+				return fmt.Errorf("import event collaborator %q: %w", c.Name, err)
+			}
+		}
+
+		for _, p := range archive.ProgrammePieces {
+			programmeID, ok := programmeIDs[p.ProgrammeID]
+			if !ok {
+				return fmt.Errorf("import programme piece: unknown programme id %d", p.ProgrammeID)
+			}
+			pieceID, ok := pieceIDs[p.PieceID]
+			if !ok {
+				return fmt.Errorf("import programme piece: unknown piece id %d", p.PieceID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO programme_pieces (programme_id, piece_id, position, notes, created_at, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				programmeID, pieceID, p.Position, p.Notes, p.CreatedAt, p.UpdatedAt); err != nil {
+				return fmt.Errorf("import programme piece: %w", err)
+			}
+		}
+
+		for _, deb := range archive.EventDebriefs {
+			eventID, ok := eventIDs[deb.EventID]
+			if !ok {
+				return fmt.Errorf("import event debrief: unknown event id %d", deb.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO event_debriefs (event_id, actual_attendance, notes, setlist_deviations, updated_by, updated_at)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				eventID, deb.ActualAttendance, deb.Notes, deb.SetlistDeviations, deb.UpdatedBy, deb.UpdatedAt); err != nil {
+				return fmt.Errorf("import event debrief: %w", err)
+			}
+		}
+
+		for _, h := range archive.CalendarHolds {
+			eventID, ok := eventIDs[h.EventID]
+			if !ok {
+				return fmt.Errorf("import calendar hold %q: unknown event id %d", h.ExternalUID, h.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO calendar_holds (external_uid, source_url, event_id, summary, starts_at, synced_at, created_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+				h.ExternalUID, h.SourceURL, eventID, h.Summary, h.StartsAt, h.SyncedAt, h.CreatedAt); err != nil {
+				return fmt.Errorf("import calendar hold %q: %w", h.ExternalUID, err)
+			}
+		}
+
+		for _, s := range archive.GoogleCalendarSyncs {
+			eventID, ok := eventIDs[s.EventID]
+			if !ok {
+				return fmt.Errorf("import google calendar sync: unknown event id %d", s.EventID)
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO google_calendar_syncs (event_id, google_event_id, synced_at)
+				VALUES ($1, $2, $3)`,
+				eventID, s.GoogleEventID, s.SyncedAt); err != nil {
+				return fmt.Errorf("import google calendar sync: %w", err)
+			}
+		}
+
+		for _, c := range archive.WebhookChannels {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO webhook_channels (name, url, payload_mode, text_template, image_url_template, link_template, created_by, updated_by, created_at, updated_at, deleted_at)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+				c.Name, c.URL, c.PayloadMode, c.TextTemplate, c.ImageURLTemplate, c.LinkTemplate, c.CreatedBy, c.UpdatedBy, c.CreatedAt, c.UpdatedAt, c.DeletedAt); err != nil {
+				return fmt.Errorf("import webhook channel %q: %w", c.Name, err)
+			}
+		}
+
+		for _, s := range archive.Subscribers {
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO subscribers (email, created_at) VALUES ($1, $2)
+				ON CONFLICT (email) DO NOTHING`,
+				s.Email, s.CreatedAt); err != nil {
+				return fmt.Errorf("import subscriber %q: %w", s.Email, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("db: import archive with remapped ids: %w", err)
+	}
+	return nil
+}
+
+// remapOptionalID looks up id in m, returning nil if id itself is nil. It
+// errors rather than silently dropping the reference if id is set but
+// absent from m, since that means the archive references a row it didn't
+// export.
+func remapOptionalID(m map[int64]int64, id *int64) (*int64, error) {
+	if id == nil {
+		return nil, nil
+	}
+	newID, ok := m[*id]
+	if !ok {
+		return nil, fmt.Errorf("unknown id %d", *id)
+	}
+	return &newID, nil
+}
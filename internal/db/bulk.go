@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// PieceImport is one row of a bulk piece import (CSV import, migration from
+// an old site).
+type PieceImport struct {
+	Title      string
+	ComposerID int64
+}
+
+// BulkInsertPieces loads pieces via COPY, orders of magnitude faster than a
+// row-by-row INSERT loop for large imports.
+func (d *DB) BulkInsertPieces(ctx context.Context, pieces []PieceImport) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	rows := make([][]interface{}, len(pieces))
+	for i, p := range pieces {
+		rows[i] = []interface{}{p.Title, p.ComposerID}
+	}
+
+	n, err := d.pool.CopyFrom(ctx,
+		pgx.Identifier{"pieces"},
+		[]string{"title", "composer_id"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return n, fmt.Errorf("db: bulk insert pieces: %w", err)
+	}
+	return n, nil
+}
+
+// EventImport is one row of a bulk event import. Slug is derived from Title
+// the same way a single CreateEvent call would, so imported events can't
+// drift from the normal insert path's naming. It doesn't carry ticket
+// offers: those need a label and URL per offer, which doesn't fit a flat
+// CSV row, so an imported event picks them up through a normal
+// UpdateEvent call afterwards.
+type EventImport struct {
+	Title       string
+	EventDate   string
+	VenueID     *int64
+	ProgrammeID *int64
+	Notes       string
+}
+
+// BulkInsertEvents loads events via COPY. Unlike CreateEvent, it doesn't run
+// inside a transaction with the rest of the request lifecycle and doesn't
+// NOTIFY per row; callers should invalidate caches once after the whole
+// batch lands.
+func (d *DB) BulkInsertEvents(ctx context.Context, events []EventImport) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	rows := make([][]interface{}, len(events))
+	for i, e := range events {
+		rows[i] = []interface{}{
+			slugify(e.Title), e.Title, "draft", e.EventDate,
+			e.VenueID, e.ProgrammeID, e.Notes,
+		}
+	}
+
+	n, err := d.pool.CopyFrom(ctx,
+		pgx.Identifier{"events"},
+		[]string{"slug", "title", "status", "event_date", "venue_id", "programme_id", "notes"},
+		pgx.CopyFromRows(rows))
+	if err != nil {
+		return n, fmt.Errorf("db: bulk insert events: %w", err)
+	}
+
+	if err := notify(ctx, d.pool, ChannelEvents, ""); err != nil {
+		return n, fmt.Errorf("db: bulk insert events: notify: %w", err)
+	}
+	return n, nil
+}
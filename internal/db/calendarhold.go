@@ -0,0 +1,88 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetCalendarHold returns the calendar hold recorded for the given
+// external (.ics) UID, or pgx.ErrNoRows if this VEVENT hasn't been
+// imported before.
+func (d *DB) GetCalendarHold(ctx context.Context, externalUID string) (*models.CalendarHold, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetCalendarHold(ctx, externalUID)
+	if err != nil {
+		return nil, fmt.Errorf("db: get calendar hold %q: %w", externalUID, err)
+	}
+	return calendarHoldFromRow(row), nil
+}
+
+// GetCalendarHoldByEvent returns the calendar hold that produced the given
+// event, or pgx.ErrNoRows if the event didn't come from the importer.
+func (d *DB) GetCalendarHoldByEvent(ctx context.Context, eventID int64) (*models.CalendarHold, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetCalendarHoldByEventID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: get calendar hold for event %d: %w", eventID, err)
+	}
+	return calendarHoldFromRow(row), nil
+}
+
+// CreateCalendarHold records a newly imported VEVENT and the draft event it
+// produced.
+func (d *DB) CreateCalendarHold(ctx context.Context, req models.CalendarHoldRequest) (*models.CalendarHold, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateCalendarHold(ctx, sqlc.CreateCalendarHoldParams{
+		ExternalUID: req.ExternalUID,
+		SourceURL:   req.SourceURL,
+		EventID:     req.EventID,
+		Summary:     req.Summary,
+		StartsAt:    req.StartsAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create calendar hold %q: %w", req.ExternalUID, err)
+	}
+	return calendarHoldFromRow(row), nil
+}
+
+// UpdateCalendarHold refreshes the stored copy of a previously imported
+// VEVENT after re-syncing it.
+func (d *DB) UpdateCalendarHold(ctx context.Context, externalUID string, req models.CalendarHoldRequest) (*models.CalendarHold, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).UpdateCalendarHold(ctx, sqlc.UpdateCalendarHoldParams{
+		ExternalUID: externalUID,
+		SourceURL:   req.SourceURL,
+		Summary:     req.Summary,
+		StartsAt:    req.StartsAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update calendar hold %q: %w", externalUID, err)
+	}
+	return calendarHoldFromRow(row), nil
+}
+
+// calendarHoldFromRow converts a generated sqlc row into the
+// models.CalendarHold the API and importer deal in.
+func calendarHoldFromRow(row sqlc.CalendarHold) *models.CalendarHold {
+	return &models.CalendarHold{
+		ID:          row.ID,
+		ExternalUID: row.ExternalUID,
+		SourceURL:   row.SourceURL,
+		EventID:     row.EventID,
+		Summary:     row.Summary,
+		StartsAt:    models.DateTime(row.StartsAt),
+		SyncedAt:    models.DateTime(row.SyncedAt),
+		CreatedAt:   models.DateTime(row.CreatedAt),
+	}
+}
@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetComposer returns the composer with the given id.
+func (d *DB) GetComposer(ctx context.Context, id int64) (*models.Composer, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	composer, err := sqlc.New(logged(ctx, d.reader())).GetComposer(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get composer %d: %w", id, err)
+	}
+	return composerFromRow(composer), nil
+}
+
+// ListComposers returns every composer.
+func (d *DB) ListComposers(ctx context.Context) ([]models.Composer, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListComposers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list composers: %w", err)
+	}
+
+	composers := make([]models.Composer, len(rows))
+	for i, row := range rows {
+		composers[i] = *composerFromRow(row)
+	}
+	return composers, nil
+}
+
+// CreateComposer inserts a new composer.
+func (d *DB) CreateComposer(ctx context.Context, name string) (*models.Composer, error) {
+	if len(name) < 1 {
+		return nil, fmt.Errorf("db: create composer: name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	composer, err := sqlc.New(logged(ctx, d.pool)).CreateComposer(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("db: create composer: %w", err)
+	}
+	return composerFromRow(composer), nil
+}
+
+// DeleteComposer moves the composer with the given id to the trash.
+func (d *DB) DeleteComposer(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteComposer(ctx, id); err != nil {
+		return fmt.Errorf("db: delete composer %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedComposers returns every composer currently in the trash.
+func (d *DB) ListTrashedComposers(ctx context.Context) ([]models.Composer, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTrashedComposers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed composers: %w", err)
+	}
+
+	composers := make([]models.Composer, len(rows))
+	for i, row := range rows {
+		composers[i] = *composerFromRow(row)
+	}
+	return composers, nil
+}
+
+// RestoreComposer takes the composer with the given id out of the trash.
+func (d *DB) RestoreComposer(ctx context.Context, id int64) (*models.Composer, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	composer, err := sqlc.New(logged(ctx, d.pool)).RestoreComposer(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore composer %d: %w", id, err)
+	}
+	return composerFromRow(composer), nil
+}
+
+// composerFromRow converts a generated sqlc row into the models.Composer
+// the API layer deals in.
+func composerFromRow(row sqlc.Composer) *models.Composer {
+	return &models.Composer{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+		DeletedAt: deletedAt(row.DeletedAt),
+	}
+}
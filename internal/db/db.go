@@ -0,0 +1,94 @@
+// Package db is the Postgres-backed persistence layer for events,
+// programmes, pieces, composers and venues.
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// replicaHealthCheckInterval is how often a background goroutine pings the
+// read replica to decide whether reads should still be routed to it.
+const replicaHealthCheckInterval = 5 * time.Second
+
+// DB wraps a connection pool with the query methods the API needs. It
+// implements EventStore, ProgrammeStore, PieceStore, ComposerStore and
+// VenueStore.
+type DB struct {
+	pool     *pgxpool.Pool
+	readPool *pgxpool.Pool // nil unless a read replica is configured
+	timeouts Timeouts
+	retryCfg RetryConfig
+
+	replicaHealthy atomic.Bool
+}
+
+// Open connects to Postgres at dsn and returns a ready-to-use DB. A zero
+// Timeouts uses DefaultTimeouts. If replicaDSN is non-empty, read-only
+// methods (Get*/List*) are routed to it, falling back to the primary if the
+// replica is unreachable.
+func Open(ctx context.Context, dsn, replicaDSN string, timeouts Timeouts) (*DB, error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("db: connect: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		return nil, fmt.Errorf("db: ping: %w", err)
+	}
+	if timeouts == (Timeouts{}) {
+		timeouts = DefaultTimeouts()
+	}
+
+	d := &DB{pool: pool, timeouts: timeouts, retryCfg: defaultRetryConfig()}
+
+	if replicaDSN != "" {
+		readPool, err := pgxpool.New(ctx, replicaDSN)
+		if err != nil {
+			return nil, fmt.Errorf("db: connect to read replica: %w", err)
+		}
+		if err := readPool.Ping(ctx); err != nil {
+			return nil, fmt.Errorf("db: ping read replica: %w", err)
+		}
+		d.readPool = readPool
+		d.replicaHealthy.Store(true)
+		go d.watchReplica()
+	}
+
+	return d, nil
+}
+
+// watchReplica periodically pings the read replica and updates
+// replicaHealthy, so reader() never blocks a query on a health check. It
+// runs for the life of the DB.
+func (d *DB) watchReplica() {
+	ticker := time.NewTicker(replicaHealthCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), d.timeouts.Read)
+		err := d.readPool.Ping(ctx)
+		cancel()
+		d.replicaHealthy.Store(err == nil)
+	}
+}
+
+// reader returns the pool read-only queries should use: the replica if one
+// is configured and currently healthy, otherwise the primary.
+func (d *DB) reader() *pgxpool.Pool {
+	if d.readPool != nil && d.replicaHealthy.Load() {
+		return d.readPool
+	}
+	return d.pool
+}
+
+// Close releases the underlying connection pool(s).
+func (d *DB) Close() {
+	d.pool.Close()
+	if d.readPool != nil {
+		d.readPool.Close()
+	}
+}
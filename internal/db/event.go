@@ -0,0 +1,846 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/queryparam"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// eventDateLayout is the format a ?filter[date]= value is expected in: a
+// plain calendar date with no time-of-day component, matching models.Date.
+const eventDateLayout = "2006-01-02"
+
+// GetEvent returns the event with the given id, or pgx.ErrNoRows if none
+// exists.
+func (d *DB) GetEvent(ctx context.Context, id int64) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	event, err := q.GetEvent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get event %d: %w", id, err)
+	}
+	events := []models.Event{*eventFromRow(event)}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event %d: %w", id, err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event %d: %w", id, err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event %d: %w", id, err)
+	}
+	return &events[0], nil
+}
+
+// GetEventBySlug returns the event with the given slug, or pgx.ErrNoRows
+// if none exists.
+func (d *DB) GetEventBySlug(ctx context.Context, slug string) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	event, err := q.GetEventBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("db: get event by slug %q: %w", slug, err)
+	}
+	events := []models.Event{*eventFromRow(event)}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event by slug %q: %w", slug, err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event by slug %q: %w", slug, err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: get event by slug %q: %w", slug, err)
+	}
+	return &events[0], nil
+}
+
+// ResolveEventSlugRedirect looks up the event a slug used to belong to
+// before its title (and so its slug) changed, returning pgx.ErrNoRows if
+// oldSlug was never a slug on record. Callers use the result to send a
+// redirect to the event's current slug instead of a 404.
+func (d *DB) ResolveEventSlugRedirect(ctx context.Context, oldSlug string) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	redirect, err := q.GetEventSlugRedirect(ctx, oldSlug)
+	if err != nil {
+		return nil, fmt.Errorf("db: resolve event slug redirect %q: %w", oldSlug, err)
+	}
+	event, err := q.GetEvent(ctx, redirect.EventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: resolve event slug redirect %q: %w", oldSlug, err)
+	}
+	return eventFromRow(event), nil
+}
+
+// EventFilter narrows a ListEvents call. The zero value matches every
+// event in unspecified order. A zero Limit means no LIMIT is applied,
+// which is what the unpaginated public feed relies on.
+type EventFilter struct {
+	Filters []queryparam.Filter
+	Sort    []queryparam.SortKey
+	// Deleted, when true, lists trashed events instead of live ones —
+	// the ?deleted=true counterpart to GET /events/trash, for a caller
+	// that wants trashed events with the same filter/sort/page support
+	// the live listing has.
+	Deleted bool
+	Limit   int
+	Offset  int
+}
+
+// EventSortFields whitelists the columns ?sort= may reference for events.
+var EventSortFields = map[string]bool{
+	"title":      true,
+	"status":     true,
+	"visibility": true,
+	"event_date": true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// EventFilterFields maps the field names ?filter[...] may reference to the
+// underlying event columns, so the query vocabulary (e.g. "date") doesn't
+// have to match the schema (event_date) 1:1, and an unlisted field can
+// never reach the query text.
+var EventFilterFields = map[string]string{
+	"status":     "status",
+	"visibility": "visibility",
+	"date":       "event_date",
+	"venue_id":   "venue_id",
+	"tour_id":    "tour_id",
+	"season_id":  "season_id",
+	"profile_id": "profile_id",
+}
+
+var filterOperators = map[queryparam.FilterOp]string{
+	queryparam.OpEq:  "=",
+	queryparam.OpNe:  "!=",
+	queryparam.OpGt:  ">",
+	queryparam.OpGte: ">=",
+	queryparam.OpLt:  "<",
+	queryparam.OpLte: "<=",
+}
+
+// eventFilterValue parses a raw ?filter[...] string into the Go value the
+// named column expects, so it binds correctly against the driver.
+func eventFilterValue(column, raw string) (interface{}, error) {
+	if column == "event_date" {
+		t, err := time.Parse(eventDateLayout, raw)
+		if err != nil {
+			return nil, fmt.Errorf("db: invalid date filter %q: %w", raw, err)
+		}
+		return t, nil
+	}
+	return raw, nil
+}
+
+// orderClause builds an ORDER BY clause from keys, rejecting any field not
+// in EventSortFields itself rather than trusting a caller to have checked
+// already — unlike EventFilterFields, a sortable field name is always the
+// column name itself, so no translation table is needed, but that also
+// means there's nothing else standing between an unlisted field and the
+// query text. A trailing "id ASC" tie-breaker keeps the order stable
+// across pages even when every named key ties, which plain sort keys
+// alone don't guarantee.
+func orderClause(keys []queryparam.SortKey) (string, error) {
+	parts := make([]string, 0, len(keys)+1)
+	for _, k := range keys {
+		if !EventSortFields[k.Field] {
+			return "", fmt.Errorf("db: field %q is not sortable", k.Field)
+		}
+		dir := "ASC"
+		if k.Desc {
+			dir = "DESC"
+		}
+		parts = append(parts, k.Field+" "+dir)
+	}
+	parts = append(parts, "id ASC")
+	return "ORDER BY " + strings.Join(parts, ", "), nil
+}
+
+// ListEvents returns the events matching filter, ordered by filter.Sort
+// if given (ties broken by id), or by id alone otherwise, along with the
+// total number of matching events regardless of filter.Limit/Offset so a
+// caller can page through the full result set. filter.Limit == 0 means
+// no LIMIT is applied. Filters are translated into a parameterized WHERE
+// clause by queryBuilder so an unlisted field or unsupported operator is
+// rejected before it ever reaches the query text.
+func (d *DB) ListEvents(ctx context.Context, filter EventFilter) ([]models.Event, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	qb := &queryBuilder{}
+	deletedCondition := "deleted_at IS NULL"
+	if filter.Deleted {
+		deletedCondition = "deleted_at IS NOT NULL"
+	}
+	conditions := []string{deletedCondition}
+	for _, f := range filter.Filters {
+		column, ok := EventFilterFields[f.Field]
+		if !ok {
+			return nil, 0, fmt.Errorf("db: field %q is not filterable", f.Field)
+		}
+		operator, ok := filterOperators[f.Op]
+		if !ok {
+			return nil, 0, fmt.Errorf("db: operator %q is not supported", f.Op)
+		}
+		value, err := eventFilterValue(column, f.Value)
+		if err != nil {
+			return nil, 0, err
+		}
+		conditions = append(conditions, column+" "+operator+" "+qb.bind(value))
+	}
+	where := whereClause(conditions...)
+	reader := logged(ctx, d.reader())
+
+	var total int
+	if err := reader.QueryRow(ctx, "SELECT count(*) FROM events "+where, qb.Args()...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("db: list events: count: %w", err)
+	}
+
+	order, err := orderClause(filter.Sort)
+	if err != nil {
+		return nil, 0, err
+	}
+	query := `
+		SELECT id, slug, title, status, event_date, start_time, venue_id, programme_id,
+		       notes, created_by, updated_by, created_at, updated_at, deleted_at, visibility, tour_id, season_id, profile_id
+		FROM events ` + where + " " + order
+	if filter.Limit > 0 {
+		query += " LIMIT " + qb.bind(filter.Limit) + " OFFSET " + qb.bind(filter.Offset)
+	}
+
+	rows, err := reader.Query(ctx, query, qb.Args()...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: list events: %w", err)
+	}
+	defer rows.Close()
+
+	rawEvents, err := pgx.CollectRows(rows, pgx.RowToStructByName[sqlc.Event])
+	if err != nil {
+		return nil, 0, fmt.Errorf("db: list events: %w", err)
+	}
+
+	events := make([]models.Event, len(rawEvents))
+	for i, row := range rawEvents {
+		events[i] = *eventFromRow(row)
+	}
+	q := sqlc.New(reader)
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, 0, fmt.Errorf("db: list events: %w", err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, 0, fmt.Errorf("db: list events: %w", err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, 0, fmt.Errorf("db: list events: %w", err)
+	}
+	return events, total, nil
+}
+
+// CreateEvent inserts a new event from req. If req.Venue is set, a new
+// venue is inserted in the same transaction and used in place of
+// req.VenueID.
+func (d *DB) CreateEvent(ctx context.Context, req models.EventRequest) (*models.Event, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: create event: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	slug := slugify(req.Title)
+	notes, _ := req.Notes.Get()
+	offerReqs, _ := req.TicketOffers.Get()
+	mediaReqs, _ := req.Media.Get()
+	collaboratorReqs, _ := req.Collaborators.Get()
+	visibility := models.VisibilityPublic
+	if v, ok := req.Visibility.Get(); ok {
+		visibility = v
+	}
+	var startTime *time.Time
+	if v, ok := req.StartTime.Get(); ok {
+		t := v.Time()
+		startTime = &t
+	}
+	var venueID, programmeID, tourID, profileID *int64
+	if v, ok := req.VenueID.Get(); ok {
+		venueID = &v
+	}
+	if v, ok := req.ProgrammeID.Get(); ok {
+		programmeID = &v
+	}
+	if v, ok := req.TourID.Get(); ok {
+		tourID = &v
+	}
+	if v, ok := req.ProfileID.Get(); ok {
+		profileID = &v
+	}
+
+	actor := auth.Actor(ctx)
+
+	var event *models.Event
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+		seasonID, err := resolveSeasonID(ctx, q, req.SeasonID, req.EventDate.Time())
+		if err != nil {
+			return err
+		}
+		if venueReq, ok := req.Venue.Get(); ok {
+			if len(venueReq.Name) < 1 {
+				return fmt.Errorf("venue name is required")
+			}
+			venue, err := q.CreateVenue(ctx, sqlc.CreateVenueParams{
+				Name:        venueReq.Name,
+				City:        venueReq.City,
+				Country:     venueReq.Country,
+				Description: venueReq.Description,
+				Timezone:    venueReq.Timezone,
+				CreatedBy:   actor,
+			})
+			if err != nil {
+				return err
+			}
+			venueID = &venue.ID
+		}
+		row, err := q.CreateEvent(ctx, sqlc.CreateEventParams{
+			Slug:        slug,
+			Title:       req.Title,
+			EventDate:   req.EventDate.Time(),
+			StartTime:   startTime,
+			VenueID:     venueID,
+			ProgrammeID: programmeID,
+			Notes:       notes,
+			CreatedBy:   actor,
+			Visibility:  visibility.String(),
+			TourID:      tourID,
+			SeasonID:    seasonID,
+			ProfileID:   profileID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := recordEventAudit(ctx, q, row.ID, "create", eventDiff(sqlc.Event{}, row)); err != nil {
+			return err
+		}
+		event = eventFromRow(row)
+		if len(offerReqs) > 0 {
+			event.TicketOffers, err = replaceTicketOffers(ctx, tx, event.ID, offerReqs)
+			if err != nil {
+				return err
+			}
+		}
+		if len(mediaReqs) > 0 {
+			event.Media, err = replaceEventMedia(ctx, tx, event.ID, mediaReqs)
+			if err != nil {
+				return err
+			}
+		}
+		if len(collaboratorReqs) > 0 {
+			event.Collaborators, err = replaceEventCollaborators(ctx, tx, event.ID, collaboratorReqs)
+			if err != nil {
+				return err
+			}
+		}
+		return notify(ctx, tx, ChannelEvents, strconv.FormatInt(event.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create event: %w", err)
+	}
+	d.refreshPerformancesView(ctx)
+	for _, m := range event.Media {
+		if m.Kind == models.MediaKindVideo {
+			d.enqueueVideoMetadataFetch(ctx, m.ID)
+		}
+	}
+	return event, nil
+}
+
+// UpdateEvent applies req to the event with the given id.
+func (d *DB) UpdateEvent(ctx context.Context, id int64, req models.EventRequest) (*models.Event, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: update event %d: %w", id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+
+	var event *models.Event
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		queries := sqlc.New(logged(ctx, tx))
+
+		current, err := queries.GetEvent(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		venueID := applyOptional(req.VenueID, current.VenueID)
+		programmeID := applyOptional(req.ProgrammeID, current.ProgrammeID)
+		tourID := applyOptional(req.TourID, current.TourID)
+		profileID := applyOptional(req.ProfileID, current.ProfileID)
+		notes := applyOptionalValue(req.Notes, current.Notes)
+
+		startTime := current.StartTime
+		if req.StartTime.Set {
+			if req.StartTime.Null {
+				startTime = nil
+			} else {
+				t := req.StartTime.Value.Time()
+				startTime = &t
+			}
+		}
+
+		seasonID, err := resolveSeasonID(ctx, queries, req.SeasonID, req.EventDate.Time())
+		if err != nil {
+			return err
+		}
+
+		// Visibility has no meaningful "cleared" state, so an explicit null
+		// resets it to public rather than to the zero value the way
+		// applyOptionalValue would for a string field.
+		visibility := models.EventVisibility(current.Visibility)
+		if req.Visibility.Set {
+			if req.Visibility.Null {
+				visibility = models.VisibilityPublic
+			} else {
+				visibility = req.Visibility.Value
+			}
+		}
+
+		// A title change regenerates the slug; the old one is kept in
+		// event_slug_redirects so a link built from it still resolves,
+		// rather than 404ing the moment the title is edited.
+		slug := current.Slug
+		if req.Title != current.Title {
+			if newSlug := slugify(req.Title); newSlug != current.Slug {
+				slug = newSlug
+				if _, err := queries.CreateEventSlugRedirect(ctx, sqlc.CreateEventSlugRedirectParams{
+					OldSlug: current.Slug,
+					EventID: id,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+
+		row, err := queries.UpdateEvent(ctx, sqlc.UpdateEventParams{
+			ID:          id,
+			Title:       req.Title,
+			EventDate:   req.EventDate.Time(),
+			StartTime:   startTime,
+			VenueID:     venueID,
+			ProgrammeID: programmeID,
+			Notes:       notes,
+			UpdatedBy:   actor,
+			Visibility:  visibility.String(),
+			TourID:      tourID,
+			SeasonID:    seasonID,
+			ProfileID:   profileID,
+			Slug:        slug,
+		})
+		if err != nil {
+			return err
+		}
+		if err := recordEventAudit(ctx, queries, id, "update", eventDiff(current, row)); err != nil {
+			return err
+		}
+		if err := recordEventRevision(ctx, queries, id, current); err != nil {
+			return err
+		}
+		event = eventFromRow(row)
+
+		if req.TicketOffers.Set {
+			offerReqs := req.TicketOffers.Value
+			event.TicketOffers, err = replaceTicketOffers(ctx, tx, event.ID, offerReqs)
+			if err != nil {
+				return err
+			}
+		} else {
+			events := []models.Event{*event}
+			if err := attachTicketOffers(ctx, queries, events); err != nil {
+				return err
+			}
+			*event = events[0]
+		}
+
+		if req.Media.Set {
+			mediaReqs := req.Media.Value
+			event.Media, err = replaceEventMedia(ctx, tx, event.ID, mediaReqs)
+			if err != nil {
+				return err
+			}
+		} else {
+			events := []models.Event{*event}
+			if err := attachEventMedia(ctx, queries, events); err != nil {
+				return err
+			}
+			*event = events[0]
+		}
+
+		if req.Collaborators.Set {
+			collaboratorReqs := req.Collaborators.Value
+			event.Collaborators, err = replaceEventCollaborators(ctx, tx, event.ID, collaboratorReqs)
+			if err != nil {
+				return err
+			}
+		} else {
+			events := []models.Event{*event}
+			if err := attachEventCollaborators(ctx, queries, events); err != nil {
+				return err
+			}
+			*event = events[0]
+		}
+		return notify(ctx, tx, ChannelEvents, strconv.FormatInt(event.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update event %d: %w", id, err)
+	}
+	d.refreshPerformancesView(ctx)
+	d.enqueueGoogleCalendarSync(ctx, id)
+	d.enqueueWebhookSocialPost(ctx, id)
+	d.enqueueNewsletterCampaign(ctx, id)
+	if req.Media.Set {
+		for _, m := range event.Media {
+			if m.Kind == models.MediaKindVideo {
+				d.enqueueVideoMetadataFetch(ctx, m.ID)
+			}
+		}
+	}
+	return event, nil
+}
+
+// DeleteEvent moves the event with the given id to the trash. It stays in
+// the database, excluded from Get/List, until RestoreEvent brings it back
+// or the purge job hard-deletes it after the retention window.
+func (d *DB) DeleteEvent(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+		if err := q.DeleteEvent(ctx, id); err != nil {
+			return err
+		}
+		if err := recordEventAudit(ctx, q, id, "delete", nil); err != nil {
+			return err
+		}
+		return notify(ctx, tx, ChannelEvents, strconv.FormatInt(id, 10))
+	})
+	if err != nil {
+		return fmt.Errorf("db: delete event %d: %w", id, err)
+	}
+	d.refreshPerformancesView(ctx)
+	d.enqueueGoogleCalendarSync(ctx, id)
+	return nil
+}
+
+// ListTrashedEvents returns every event currently in the trash.
+func (d *DB) ListTrashedEvents(ctx context.Context) ([]models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListTrashedEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed events: %w", err)
+	}
+
+	events := make([]models.Event, len(rows))
+	for i, row := range rows {
+		events[i] = *eventFromRow(row)
+	}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list trashed events: %w", err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list trashed events: %w", err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list trashed events: %w", err)
+	}
+	return events, nil
+}
+
+// RestoreEvent takes the event with the given id out of the trash.
+func (d *DB) RestoreEvent(ctx context.Context, id int64) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	var event *models.Event
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+		row, err := q.RestoreEvent(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := recordEventAudit(ctx, q, id, "restore", nil); err != nil {
+			return err
+		}
+		events := []models.Event{*eventFromRow(row)}
+		if err := attachTicketOffers(ctx, q, events); err != nil {
+			return err
+		}
+		if err := attachEventMedia(ctx, q, events); err != nil {
+			return err
+		}
+		if err := attachEventCollaborators(ctx, q, events); err != nil {
+			return err
+		}
+		event = &events[0]
+		return notify(ctx, tx, ChannelEvents, strconv.FormatInt(event.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: restore event %d: %w", id, err)
+	}
+	d.refreshPerformancesView(ctx)
+	d.enqueueGoogleCalendarSync(ctx, id)
+	return event, nil
+}
+
+// enqueueGoogleCalendarSyncPayload is the outbox payload for a
+// JobKindGoogleCalendarSync job: just the event id. The handler re-fetches
+// the event's current state rather than being told what changed, so it
+// self-heals regardless of which mutation triggered the enqueue.
+type enqueueGoogleCalendarSyncPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// enqueueGoogleCalendarSync queues a best-effort job to reconcile id's
+// Google Calendar mirror. It's called outside the write's transaction, the
+// same way refreshPerformancesView is: a failure to enqueue just leaves the
+// mirror stale until the next successful write, rather than failing the
+// event write that triggered it.
+func (d *DB) enqueueGoogleCalendarSync(ctx context.Context, id int64) {
+	payload, err := json.Marshal(enqueueGoogleCalendarSyncPayload{EventID: id})
+	if err != nil {
+		log.Printf("db: marshal google calendar sync payload for event %d: %v", id, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindGoogleCalendarSync, payload, 0); err != nil {
+		log.Printf("db: enqueue google calendar sync for event %d: %v", id, err)
+	}
+}
+
+// enqueueWebhookSocialPostPayload is the outbox payload for a
+// JobKindWebhookSocialPost job: just the event id, matching
+// enqueueGoogleCalendarSyncPayload.
+type enqueueWebhookSocialPostPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// enqueueWebhookSocialPost queues a best-effort job to post id's social
+// payload to every webhook channel, if it's now published. It's called
+// from UpdateEvent and from TransitionEventStatus on a move to
+// StatusPublished: a delete or restore never causes a fresh publish, and
+// webhooks.Poster's own webhook_posts bookkeeping keeps a still-published
+// event from being posted twice as later updates enqueue further jobs.
+func (d *DB) enqueueWebhookSocialPost(ctx context.Context, id int64) {
+	payload, err := json.Marshal(enqueueWebhookSocialPostPayload{EventID: id})
+	if err != nil {
+		log.Printf("db: marshal webhook social post payload for event %d: %v", id, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindWebhookSocialPost, payload, 0); err != nil {
+		log.Printf("db: enqueue webhook social post for event %d: %v", id, err)
+	}
+}
+
+// enqueueNewsletterCampaignPayload is the outbox payload for a
+// JobKindNewsletterDraftCampaign job: just the event id, matching
+// enqueueGoogleCalendarSyncPayload.
+type enqueueNewsletterCampaignPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// enqueueNewsletterCampaign queues a best-effort job to draft a newsletter
+// campaign for id, if it's now published. Like enqueueWebhookSocialPost,
+// it's called from UpdateEvent and from TransitionEventStatus on a
+// publish, and newsletter.Syncer's own
+// newsletter_campaigns bookkeeping keeps a still-published event from
+// getting a second draft as later updates enqueue further jobs.
+func (d *DB) enqueueNewsletterCampaign(ctx context.Context, id int64) {
+	payload, err := json.Marshal(enqueueNewsletterCampaignPayload{EventID: id})
+	if err != nil {
+		log.Printf("db: marshal newsletter campaign payload for event %d: %v", id, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindNewsletterDraftCampaign, payload, 0); err != nil {
+		log.Printf("db: enqueue newsletter campaign for event %d: %v", id, err)
+	}
+}
+
+// RollForwardEvent clones the event with the given id years years ahead,
+// for scheduling next year's instance of an annual engagement. The clone
+// goes through CreateEvent, so it starts life as a draft with a season
+// resolved from its own (shifted) date, the same as any other new event;
+// only the title, date, start time and venue/programme/profile links
+// carry over from the original. Ticket offers, media and collaborators don't, since they
+// belong to the outing being cloned rather than the one being planned.
+func (d *DB) RollForwardEvent(ctx context.Context, id int64, years int) (*models.Event, error) {
+	original, err := d.GetEvent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: roll forward event %d: %w", id, err)
+	}
+
+	req := models.EventRequest{
+		Title:     original.Title,
+		EventDate: models.Date(original.EventDate.Time().AddDate(years, 0, 0)),
+	}
+	if original.StartTime != nil {
+		req.StartTime = models.Optional[models.TimeOfDay]{Set: true, Value: *original.StartTime}
+	}
+	if original.VenueID != nil {
+		req.VenueID = models.Optional[int64]{Set: true, Value: *original.VenueID}
+	}
+	if original.ProgrammeID != nil {
+		req.ProgrammeID = models.Optional[int64]{Set: true, Value: *original.ProgrammeID}
+	}
+	if original.ProfileID != nil {
+		req.ProfileID = models.Optional[int64]{Set: true, Value: *original.ProfileID}
+	}
+
+	event, err := d.CreateEvent(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("db: roll forward event %d: %w", id, err)
+	}
+	return event, nil
+}
+
+// DuplicateEvent clones event id as a new draft event (the status every
+// CreateEvent starts at), copying its title, venue and programme links
+// the same way RollForwardEvent does. EventDate is req.NewDate when
+// given, or the original's date otherwise, since EventDate can't be
+// null. Ticket offers, media and collaborators don't carry over, for the
+// same reason RollForwardEvent leaves them behind.
+func (d *DB) DuplicateEvent(ctx context.Context, id int64, req models.EventDuplicateRequest) (*models.Event, error) {
+	original, err := d.GetEvent(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: duplicate event %d: %w", id, err)
+	}
+
+	eventDate := original.EventDate
+	if newDate, ok := req.NewDate.Get(); ok {
+		eventDate = newDate
+	}
+
+	dupReq := models.EventRequest{
+		Title:     original.Title,
+		EventDate: eventDate,
+	}
+	if original.StartTime != nil {
+		dupReq.StartTime = models.Optional[models.TimeOfDay]{Set: true, Value: *original.StartTime}
+	}
+	if original.VenueID != nil {
+		dupReq.VenueID = models.Optional[int64]{Set: true, Value: *original.VenueID}
+	}
+	if original.ProgrammeID != nil {
+		dupReq.ProgrammeID = models.Optional[int64]{Set: true, Value: *original.ProgrammeID}
+	}
+	if original.ProfileID != nil {
+		dupReq.ProfileID = models.Optional[int64]{Set: true, Value: *original.ProfileID}
+	}
+
+	event, err := d.CreateEvent(ctx, dupReq)
+	if err != nil {
+		return nil, fmt.Errorf("db: duplicate event %d: %w", id, err)
+	}
+	return event, nil
+}
+
+// ListIncompleteUpcomingEvents returns events happening within leadTime
+// from now that are still drafts or are missing a venue or a ticket offer,
+// ordered by date. It's used by the reminder digest, not exposed over the
+// API.
+func (d *DB) ListIncompleteUpcomingEvents(ctx context.Context, leadTime time.Duration) ([]models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListIncompleteUpcomingEvents(ctx, time.Now().Add(leadTime))
+	if err != nil {
+		return nil, fmt.Errorf("db: list incomplete upcoming events: %w", err)
+	}
+
+	events := make([]models.Event, len(rows))
+	for i, row := range rows {
+		events[i] = *eventFromRow(row)
+	}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list incomplete upcoming events: %w", err)
+	}
+	return events, nil
+}
+
+// eventFromRow converts a generated sqlc row into the models.Event the API
+// layer deals in. TicketOffers, Media and Collaborators are left nil;
+// callers attach them separately with attachTicketOffers,
+// attachEventMedia and attachEventCollaborators since none of them is a
+// column on this row.
+func eventFromRow(row sqlc.Event) *models.Event {
+	return &models.Event{
+		ID:                 row.ID,
+		Slug:               row.Slug,
+		Title:              row.Title,
+		Status:             models.EventStatus(row.Status),
+		CancellationReason: row.CancellationReason,
+		Visibility:         models.EventVisibility(row.Visibility),
+		EventDate:          models.Date(row.EventDate),
+		StartTime:          startTime(row.StartTime),
+		VenueID:            row.VenueID,
+		ProgrammeID:        row.ProgrammeID,
+		TourID:             row.TourID,
+		SeasonID:           row.SeasonID,
+		ProfileID:          row.ProfileID,
+		Notes:              row.Notes,
+		CreatedBy:          row.CreatedBy,
+		UpdatedBy:          row.UpdatedBy,
+		CreatedAt:          models.DateTime(row.CreatedAt),
+		UpdatedAt:          models.DateTime(row.UpdatedAt),
+		DeletedAt:          deletedAt(row.DeletedAt),
+	}
+}
+
+// slugify produces a URL-safe slug from a title. It's intentionally simple;
+// collisions are expected to be rare enough for manual resolution for now.
+func slugify(title string) string {
+	out := make([]byte, 0, len(title))
+	lastDash := true
+	for _, r := range title {
+		switch {
+		case r >= 'a' && r <= 'z' || r >= '0' && r <= '9':
+			out = append(out, byte(r))
+			lastDash = false
+		case r >= 'A' && r <= 'Z':
+			out = append(out, byte(r-'A'+'a'))
+			lastDash = false
+		default:
+			if !lastDash {
+				out = append(out, '-')
+				lastDash = true
+			}
+		}
+	}
+	for len(out) > 0 && out[len(out)-1] == '-' {
+		out = out[:len(out)-1]
+	}
+	return string(out)
+}
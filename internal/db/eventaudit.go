@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// auditFieldChange is one changed field in an event_audit diff: its value
+// before and after the write that's being recorded.
+type auditFieldChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// eventDiff compares before and after and returns the fields that
+// changed, keyed by their column name, so "who changed the concert
+// date" is answerable by grepping event_audit for an event_date key.
+// Sub-resources (ticket offers, media, collaborators) aren't columns on
+// this row and aren't covered here.
+func eventDiff(before, after sqlc.Event) map[string]auditFieldChange {
+	diff := map[string]auditFieldChange{}
+	if before.Title != after.Title {
+		diff["title"] = auditFieldChange{before.Title, after.Title}
+	}
+	if !before.EventDate.Equal(after.EventDate) {
+		diff["event_date"] = auditFieldChange{before.EventDate, after.EventDate}
+	}
+	if !timePtrEqual(before.StartTime, after.StartTime) {
+		diff["start_time"] = auditFieldChange{before.StartTime, after.StartTime}
+	}
+	if !int64PtrEqual(before.VenueID, after.VenueID) {
+		diff["venue_id"] = auditFieldChange{before.VenueID, after.VenueID}
+	}
+	if !int64PtrEqual(before.ProgrammeID, after.ProgrammeID) {
+		diff["programme_id"] = auditFieldChange{before.ProgrammeID, after.ProgrammeID}
+	}
+	if before.Notes != after.Notes {
+		diff["notes"] = auditFieldChange{before.Notes, after.Notes}
+	}
+	if before.Visibility != after.Visibility {
+		diff["visibility"] = auditFieldChange{before.Visibility, after.Visibility}
+	}
+	if !int64PtrEqual(before.TourID, after.TourID) {
+		diff["tour_id"] = auditFieldChange{before.TourID, after.TourID}
+	}
+	if !int64PtrEqual(before.SeasonID, after.SeasonID) {
+		diff["season_id"] = auditFieldChange{before.SeasonID, after.SeasonID}
+	}
+	if !int64PtrEqual(before.ProfileID, after.ProfileID) {
+		diff["profile_id"] = auditFieldChange{before.ProfileID, after.ProfileID}
+	}
+	if before.Slug != after.Slug {
+		diff["slug"] = auditFieldChange{before.Slug, after.Slug}
+	}
+	return diff
+}
+
+func int64PtrEqual(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func timePtrEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// recordEventAudit writes one event_audit row inside the caller's
+// transaction, so an audit entry never exists without the write it
+// describes actually having committed, or vice versa.
+func recordEventAudit(ctx context.Context, q *sqlc.Queries, eventID int64, action string, diff any) error {
+	payload, err := json.Marshal(diff)
+	if err != nil {
+		return fmt.Errorf("marshal event audit diff: %w", err)
+	}
+	_, err = q.CreateEventAudit(ctx, sqlc.CreateEventAuditParams{
+		EventID: eventID,
+		Action:  action,
+		Actor:   auth.Actor(ctx),
+		Diff:    string(payload),
+	})
+	return err
+}
+
+// ListEventHistory returns every recorded change to the event with the
+// given id, most recent first.
+func (d *DB) ListEventHistory(ctx context.Context, eventID int64) ([]models.EventAuditEntry, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListEventAudit(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: list history for event %d: %w", eventID, err)
+	}
+
+	entries := make([]models.EventAuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = models.EventAuditEntry{
+			ID:        row.ID,
+			EventID:   row.EventID,
+			Action:    row.Action,
+			Actor:     row.Actor,
+			Diff:      json.RawMessage(row.Diff),
+			CreatedAt: models.DateTime(row.CreatedAt),
+		}
+	}
+	return entries, nil
+}
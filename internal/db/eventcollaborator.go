@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// eventCollaboratorFromRow converts a generated sqlc row into the
+// models.EventCollaborator the API layer deals in.
+func eventCollaboratorFromRow(row sqlc.EventCollaborator) models.EventCollaborator {
+	return models.EventCollaborator{
+		ID:        row.ID,
+		Name:      row.Name,
+		Role:      row.Role,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachEventCollaborators fills in Collaborators on every event in place
+// with a single batched query, rather than one query per event, since a
+// listing endpoint can return dozens of events at once.
+func attachEventCollaborators(ctx context.Context, q *sqlc.Queries, events []models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(events))
+	byID := make(map[int64]*models.Event, len(events))
+	for i := range events {
+		ids[i] = events[i].ID
+		byID[events[i].ID] = &events[i]
+	}
+
+	rows, err := q.ListEventCollaboratorsByEventIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list event collaborators: %w", err)
+	}
+	for _, row := range rows {
+		event, ok := byID[row.EventID]
+		if !ok {
+			continue
+		}
+		event.Collaborators = append(event.Collaborators, eventCollaboratorFromRow(row))
+	}
+	return nil
+}
+
+// replaceEventCollaborators validates each of reqs individually
+// (validate.Struct doesn't recurse into slices) and replaces the event's
+// entire set of credits with them. It's a full delete-then-insert rather
+// than a diff against the existing rows: EventRequest.Collaborators is a
+// full-replace field, and events rarely carry more than a handful of
+// guest credits.
+func replaceEventCollaborators(ctx context.Context, tx pgx.Tx, eventID int64, reqs []models.EventCollaboratorRequest) ([]models.EventCollaborator, error) {
+	q := sqlc.New(logged(ctx, tx))
+
+	for i, req := range reqs {
+		if err := validate.Struct(req); err != nil {
+			return nil, fmt.Errorf("event collaborator %d: %w", i, err)
+		}
+	}
+
+	if err := q.DeleteEventCollaborators(ctx, eventID); err != nil {
+		return nil, fmt.Errorf("delete existing event collaborators: %w", err)
+	}
+
+	collaborators := make([]models.EventCollaborator, len(reqs))
+	for i, req := range reqs {
+		row, err := q.CreateEventCollaborator(ctx, sqlc.CreateEventCollaboratorParams{
+			EventID: eventID,
+			Name:    req.Name,
+			Role:    req.Role,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create event collaborator: %w", err)
+		}
+		collaborators[i] = eventCollaboratorFromRow(row)
+	}
+	return collaborators, nil
+}
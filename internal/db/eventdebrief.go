@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// GetEventDebrief returns the debrief for the event with the given id, or
+// pgx.ErrNoRows if it hasn't been debriefed yet.
+func (d *DB) GetEventDebrief(ctx context.Context, eventID int64) (*models.EventDebrief, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetEventDebrief(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: get debrief for event %d: %w", eventID, err)
+	}
+	return eventDebriefFromRow(row), nil
+}
+
+// UpsertEventDebrief creates or replaces the debrief for the event with the
+// given id, rejecting the write until the event's date has passed: there's
+// nothing to debrief before the show has actually happened.
+func (d *DB) UpsertEventDebrief(ctx context.Context, eventID int64, req models.EventDebriefRequest) (*models.EventDebrief, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: update debrief for event %d: %w", eventID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.pool))
+
+	event, err := q.GetEvent(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: update debrief for event %d: %w", eventID, err)
+	}
+	if event.EventDate.After(time.Now()) {
+		err := validate.Errors{{Field: "event_date", Rule: "event has not happened yet"}}
+		return nil, fmt.Errorf("db: update debrief for event %d: %w", eventID, err)
+	}
+
+	var currentAttendance *int64
+	var currentNotes, currentDeviations string
+	current, err := q.GetEventDebrief(ctx, eventID)
+	switch {
+	case err == nil:
+		currentAttendance = current.ActualAttendance
+		currentNotes = current.Notes
+		currentDeviations = current.SetlistDeviations
+	case errors.Is(err, pgx.ErrNoRows):
+		// No debrief yet; the zero values above are the right starting
+		// point for a first write.
+	default:
+		return nil, fmt.Errorf("db: update debrief for event %d: %w", eventID, err)
+	}
+
+	actor := auth.Actor(ctx)
+	row, err := q.UpsertEventDebrief(ctx, sqlc.UpsertEventDebriefParams{
+		EventID:           eventID,
+		ActualAttendance:  applyOptional(req.ActualAttendance, currentAttendance),
+		Notes:             applyOptionalValue(req.Notes, currentNotes),
+		SetlistDeviations: applyOptionalValue(req.SetlistDeviations, currentDeviations),
+		UpdatedBy:         actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update debrief for event %d: %w", eventID, err)
+	}
+	return eventDebriefFromRow(row), nil
+}
+
+// eventDebriefFromRow converts a generated sqlc row into the
+// models.EventDebrief the API layer deals in.
+func eventDebriefFromRow(row sqlc.EventDebrief) *models.EventDebrief {
+	return &models.EventDebrief{
+		EventID:           row.EventID,
+		ActualAttendance:  row.ActualAttendance,
+		Notes:             row.Notes,
+		SetlistDeviations: row.SetlistDeviations,
+		UpdatedBy:         row.UpdatedBy,
+		UpdatedAt:         models.DateTime(row.UpdatedAt),
+	}
+}
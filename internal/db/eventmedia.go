@@ -0,0 +1,171 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// eventMediaFromRow converts a generated sqlc row into the models.EventMedia
+// the API layer deals in.
+func eventMediaFromRow(row sqlc.EventMedia) models.EventMedia {
+	return models.EventMedia{
+		ID:                row.ID,
+		Kind:              row.Kind,
+		URL:               row.URL,
+		Label:             row.Label,
+		Title:             row.Title,
+		DurationSeconds:   row.DurationSeconds,
+		ThumbnailURL:      row.ThumbnailUrl,
+		MetadataFetchedAt: deletedAt(row.MetadataFetchedAt),
+		CreatedAt:         models.DateTime(row.CreatedAt),
+		UpdatedAt:         models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachEventMedia fills in Media on every event in place with a single
+// batched query, rather than one query per event, since a listing endpoint
+// can return dozens of events at once.
+func attachEventMedia(ctx context.Context, q *sqlc.Queries, events []models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(events))
+	byID := make(map[int64]*models.Event, len(events))
+	for i := range events {
+		ids[i] = events[i].ID
+		byID[events[i].ID] = &events[i]
+	}
+
+	rows, err := q.ListEventMediaByEventIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list event media: %w", err)
+	}
+	for _, row := range rows {
+		event, ok := byID[row.EventID]
+		if !ok {
+			continue
+		}
+		event.Media = append(event.Media, eventMediaFromRow(row))
+	}
+	return nil
+}
+
+// replaceEventMedia validates each of reqs individually (validate.Struct
+// doesn't recurse into slices) and replaces the event's entire set of
+// attachments with them. It's a full delete-then-insert rather than a diff
+// against the existing rows: EventRequest.Media is a full-replace field,
+// and events rarely carry more than a poster and a programme.
+func replaceEventMedia(ctx context.Context, tx pgx.Tx, eventID int64, reqs []models.EventMediaRequest) ([]models.EventMedia, error) {
+	q := sqlc.New(logged(ctx, tx))
+
+	for i, req := range reqs {
+		if err := validate.Struct(req); err != nil {
+			return nil, fmt.Errorf("event media %d: %w", i, err)
+		}
+	}
+
+	if err := q.DeleteEventMedia(ctx, eventID); err != nil {
+		return nil, fmt.Errorf("delete existing event media: %w", err)
+	}
+
+	media := make([]models.EventMedia, len(reqs))
+	for i, req := range reqs {
+		row, err := q.CreateEventMedia(ctx, sqlc.CreateEventMediaParams{
+			EventID: eventID,
+			Kind:    req.Kind,
+			URL:     req.URL,
+			Label:   req.Label,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create event media: %w", err)
+		}
+		media[i] = eventMediaFromRow(row)
+	}
+	return media, nil
+}
+
+// AddEventMedia attaches a single media item to the event with the given
+// id without disturbing its existing media, unlike EventRequest.Media's
+// full-replace semantics — the upload endpoint only ever adds one file at
+// a time and has no reason to know about the others.
+func (d *DB) AddEventMedia(ctx context.Context, eventID int64, kind, url, label string) (*models.EventMedia, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateEventMedia(ctx, sqlc.CreateEventMediaParams{
+		EventID: eventID,
+		Kind:    kind,
+		URL:     url,
+		Label:   label,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: add event media: %w", err)
+	}
+	media := eventMediaFromRow(row)
+	return &media, nil
+}
+
+// GetEventMedia returns a single event media row by id, for the
+// youtube.Fetcher job to re-read the current state before persisting
+// fetched metadata.
+func (d *DB) GetEventMedia(ctx context.Context, id int64) (*models.EventMedia, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetEventMedia(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get event media %d: %w", id, err)
+	}
+	media := eventMediaFromRow(row)
+	return &media, nil
+}
+
+// UpdateEventMediaMetadata records title, duration and thumbnail fetched
+// for a MediaKindVideo row and stamps MetadataFetchedAt. It's called by
+// youtube.Fetcher, never directly by an HTTP handler.
+func (d *DB) UpdateEventMediaMetadata(ctx context.Context, id int64, title string, durationSeconds *int32, thumbnailURL string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	err := sqlc.New(logged(ctx, d.pool)).UpdateEventMediaMetadata(ctx, sqlc.UpdateEventMediaMetadataParams{
+		ID:              id,
+		Title:           title,
+		DurationSeconds: durationSeconds,
+		ThumbnailUrl:    thumbnailURL,
+	})
+	if err != nil {
+		return fmt.Errorf("db: update event media metadata %d: %w", id, err)
+	}
+	return nil
+}
+
+// enqueueVideoMetadataFetchPayload is the outbox payload for a
+// JobKindVideoMetadataFetch job.
+type enqueueVideoMetadataFetchPayload struct {
+	EventMediaID int64 `json:"event_media_id"`
+}
+
+// enqueueVideoMetadataFetch queues a best-effort job to fetch title,
+// duration and thumbnail for a MediaKindVideo row from the YouTube Data
+// API. It follows the same log-and-swallow pattern as
+// enqueueGoogleCalendarSync: a video embed is still usable without its
+// metadata, so a failure to enqueue shouldn't fail the event save.
+func (d *DB) enqueueVideoMetadataFetch(ctx context.Context, id int64) {
+	payload, err := json.Marshal(enqueueVideoMetadataFetchPayload{EventMediaID: id})
+	if err != nil {
+		log.Printf("db: marshal video metadata fetch payload for event media %d: %v", id, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindVideoMetadataFetch, payload, 0); err != nil {
+		log.Printf("db: enqueue video metadata fetch for event media %d: %v", id, err)
+	}
+}
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// eventSnapshot is the full editable content of an event at a point in
+// time, serialized into an event_revisions row. It deliberately excludes
+// ticket offers, media and collaborators, the same sub-resources
+// eventDiff leaves out of the audit trail, since a rollback restores the
+// event itself and leaves those alone.
+type eventSnapshot struct {
+	Title       string     `json:"title"`
+	EventDate   string     `json:"event_date"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+	Visibility  string     `json:"visibility"`
+	VenueID     *int64     `json:"venue_id,omitempty"`
+	ProgrammeID *int64     `json:"programme_id,omitempty"`
+	TourID      *int64     `json:"tour_id,omitempty"`
+	SeasonID    *int64     `json:"season_id,omitempty"`
+	ProfileID   *int64     `json:"profile_id,omitempty"`
+	Notes       string     `json:"notes"`
+}
+
+// snapshotEvent captures row's content as an eventSnapshot.
+func snapshotEvent(row sqlc.Event) eventSnapshot {
+	return eventSnapshot{
+		Title:       row.Title,
+		EventDate:   row.EventDate.Format(eventDateLayout),
+		StartTime:   row.StartTime,
+		Visibility:  row.Visibility,
+		VenueID:     row.VenueID,
+		ProgrammeID: row.ProgrammeID,
+		TourID:      row.TourID,
+		SeasonID:    row.SeasonID,
+		ProfileID:   row.ProfileID,
+		Notes:       row.Notes,
+	}
+}
+
+// toEventRequest turns a snapshot back into the EventRequest RollbackEventRevision
+// feeds to UpdateEvent, pinning every field explicitly (including SeasonID,
+// which UpdateEvent would otherwise auto-reassign from EventDate) so the
+// rollback reproduces exactly what was snapshotted.
+func (s eventSnapshot) toEventRequest() (models.EventRequest, error) {
+	eventDate, err := time.Parse(eventDateLayout, s.EventDate)
+	if err != nil {
+		return models.EventRequest{}, fmt.Errorf("invalid snapshot event_date %q: %w", s.EventDate, err)
+	}
+	req := models.EventRequest{
+		Title:       s.Title,
+		EventDate:   models.Date(eventDate),
+		Visibility:  models.Optional[models.EventVisibility]{Set: true, Value: models.EventVisibility(s.Visibility)},
+		Notes:       models.Optional[string]{Set: true, Value: s.Notes},
+		VenueID:     optionalInt64Ptr(s.VenueID),
+		ProgrammeID: optionalInt64Ptr(s.ProgrammeID),
+		TourID:      optionalInt64Ptr(s.TourID),
+		SeasonID:    optionalInt64Ptr(s.SeasonID),
+		ProfileID:   optionalInt64Ptr(s.ProfileID),
+	}
+	if s.StartTime != nil {
+		st := models.TimeOfDay(*s.StartTime)
+		req.StartTime = models.Optional[models.TimeOfDay]{Set: true, Value: st}
+	} else {
+		req.StartTime = models.Optional[models.TimeOfDay]{Set: true, Null: true}
+	}
+	return req, nil
+}
+
+// optionalInt64Ptr builds an explicitly-set Optional[int64] from a
+// possibly-nil pointer, so absent (nil) becomes an explicit null rather
+// than "leave unchanged" — a rollback always pins every field.
+func optionalInt64Ptr(v *int64) models.Optional[int64] {
+	if v == nil {
+		return models.Optional[int64]{Set: true, Null: true}
+	}
+	return models.Optional[int64]{Set: true, Value: *v}
+}
+
+// recordEventRevision snapshots before as the next revision for eventID,
+// inside the caller's transaction, so a revision is only ever recorded
+// alongside the update that made it the past.
+func recordEventRevision(ctx context.Context, q *sqlc.Queries, eventID int64, before sqlc.Event) error {
+	count, err := q.CountEventRevisions(ctx, eventID)
+	if err != nil {
+		return fmt.Errorf("count event revisions: %w", err)
+	}
+	payload, err := json.Marshal(snapshotEvent(before))
+	if err != nil {
+		return fmt.Errorf("marshal event snapshot: %w", err)
+	}
+	_, err = q.CreateEventRevision(ctx, sqlc.CreateEventRevisionParams{
+		EventID:   eventID,
+		Revision:  int32(count) + 1,
+		Snapshot:  string(payload),
+		CreatedBy: auth.Actor(ctx),
+	})
+	return err
+}
+
+// ListEventRevisions returns every recorded revision of the event with
+// the given id, most recent first.
+func (d *DB) ListEventRevisions(ctx context.Context, eventID int64) ([]models.EventRevision, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListEventRevisions(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: list revisions for event %d: %w", eventID, err)
+	}
+
+	revisions := make([]models.EventRevision, len(rows))
+	for i, row := range rows {
+		revisions[i] = models.EventRevision{
+			ID:        row.ID,
+			EventID:   row.EventID,
+			Revision:  int(row.Revision),
+			Snapshot:  json.RawMessage(row.Snapshot),
+			CreatedBy: row.CreatedBy,
+			CreatedAt: models.DateTime(row.CreatedAt),
+		}
+	}
+	return revisions, nil
+}
+
+// RollbackEventRevision restores the event with the given id to the
+// content it had at revision. It's implemented as an ordinary UpdateEvent
+// call built from the snapshot, so a rollback goes through the same
+// slug-redirect, revision-recording and audit-trail machinery any other
+// update does, rather than writing around it.
+func (d *DB) RollbackEventRevision(ctx context.Context, eventID int64, revision int) (*models.Event, error) {
+	readCtx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	row, err := sqlc.New(logged(readCtx, d.reader())).GetEventRevision(readCtx, sqlc.GetEventRevisionParams{
+		EventID:  eventID,
+		Revision: int32(revision),
+	})
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("db: rollback event %d to revision %d: %w", eventID, revision, err)
+	}
+
+	var snapshot eventSnapshot
+	if err := json.Unmarshal([]byte(row.Snapshot), &snapshot); err != nil {
+		return nil, fmt.Errorf("db: rollback event %d to revision %d: %w", eventID, revision, err)
+	}
+	req, err := snapshot.toEventRequest()
+	if err != nil {
+		return nil, fmt.Errorf("db: rollback event %d to revision %d: %w", eventID, revision, err)
+	}
+
+	event, err := d.UpdateEvent(ctx, eventID, req)
+	if err != nil {
+		return nil, fmt.Errorf("db: rollback event %d to revision %d: %w", eventID, revision, err)
+	}
+	return event, nil
+}
@@ -0,0 +1,65 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+)
+
+// EventsByMonth is the count of non-deleted, non-cancelled events whose
+// event_date falls in a single calendar month.
+type EventsByMonth struct {
+	Month time.Time `json:"month"`
+	Count int64     `json:"count"`
+}
+
+// EventStats is the set of aggregates behind GET /events/stats: an admin
+// dashboard summary of the events table as a whole, unlike PerformanceStats,
+// which is scoped to public, published events only.
+type EventStats struct {
+	DraftCount     int64           `json:"draft_count"`
+	PublishedCount int64           `json:"published_count"`
+	ArchivedCount  int64           `json:"archived_count"`
+	CancelledCount int64           `json:"cancelled_count"`
+	UpcomingCount  int64           `json:"upcoming_count"`
+	EventsByMonth  []EventsByMonth `json:"events_by_month"`
+}
+
+// EventStats computes the aggregates behind GET /events/stats. The status
+// counts and the upcoming count come from a single query using FILTER
+// clauses; the next year's per-month breakdown needs its own query built
+// on generate_series, so months with no events still appear with a zero
+// count instead of being missing. It uses the Report timeout class, like
+// PerformanceStats, since it scans every non-deleted event rather than a
+// bounded page of them.
+func (d *DB) EventStats(ctx context.Context) (*EventStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+
+	counts, err := q.CountEventsByStatus(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: event stats: %w", err)
+	}
+
+	monthRows, err := q.CountUpcomingEventsByMonth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: event stats: %w", err)
+	}
+	eventsByMonth := make([]EventsByMonth, len(monthRows))
+	for i, row := range monthRows {
+		eventsByMonth[i] = EventsByMonth{Month: row.Month, Count: row.Count}
+	}
+
+	return &EventStats{
+		DraftCount:     counts.DraftCount,
+		PublishedCount: counts.PublishedCount,
+		ArchivedCount:  counts.ArchivedCount,
+		CancelledCount: counts.CancelledCount,
+		UpcomingCount:  counts.UpcomingCount,
+		EventsByMonth:  eventsByMonth,
+	}, nil
+}
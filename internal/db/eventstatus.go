@@ -0,0 +1,117 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// InvalidStatusTransitionError reports that an event can't move directly
+// from From to To, per models.EventStatus.CanTransitionTo.
+type InvalidStatusTransitionError struct {
+	From, To models.EventStatus
+}
+
+func (e *InvalidStatusTransitionError) Error() string {
+	return fmt.Sprintf("db: cannot transition event status from %s to %s", e.From, e.To)
+}
+
+// NotReadyToPublishError reports that an event is missing a venue or a
+// ticket offer, the same readiness bar ListIncompleteUpcomingEvents uses
+// to flag events that shouldn't go live yet.
+type NotReadyToPublishError struct{ EventID int64 }
+
+func (e *NotReadyToPublishError) Error() string {
+	return fmt.Sprintf("db: event %d is not ready to publish: needs a venue and at least one ticket offer", e.EventID)
+}
+
+// TransitionEventStatus moves the event with the given id to next,
+// rejecting the move if models.EventStatus.CanTransitionTo says it isn't
+// allowed. Transitioning to StatusPublished re-runs the same readiness
+// check ListIncompleteUpcomingEvents uses to flag events that shouldn't
+// be public yet, so republishing an archived event without a venue or
+// ticket offer fails the same way a first publish would. reason is only
+// kept when next is StatusCancelled; any other transition clears it, so
+// an event's cancellation reason never outlives the cancellation it
+// explains.
+func (d *DB) TransitionEventStatus(ctx context.Context, id int64, next models.EventStatus, reason string) (*models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if next != models.StatusCancelled {
+		reason = ""
+	}
+
+	var event *models.Event
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		queries := sqlc.New(logged(ctx, tx))
+
+		current, err := queries.GetEvent(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		from := models.EventStatus(current.Status)
+		if !from.CanTransitionTo(next) {
+			return &InvalidStatusTransitionError{From: from, To: next}
+		}
+
+		if next == models.StatusPublished {
+			offers, err := queries.ListTicketOffersByEventIDs(ctx, []int64{id})
+			if err != nil {
+				return err
+			}
+			if current.VenueID == nil || len(offers) == 0 {
+				return &NotReadyToPublishError{EventID: id}
+			}
+		}
+
+		row, err := queries.UpdateEventStatus(ctx, sqlc.UpdateEventStatusParams{
+			ID:                 id,
+			Status:             next.String(),
+			CancellationReason: reason,
+			UpdatedBy:          auth.Actor(ctx),
+		})
+		if err != nil {
+			return err
+		}
+		diff := map[string]auditFieldChange{
+			"status": {Before: from.String(), After: next.String()},
+		}
+		if current.CancellationReason != reason {
+			diff["cancellation_reason"] = auditFieldChange{Before: current.CancellationReason, After: reason}
+		}
+		if err := recordEventAudit(ctx, queries, id, "status_change", diff); err != nil {
+			return err
+		}
+
+		events := []models.Event{*eventFromRow(row)}
+		if err := attachTicketOffers(ctx, queries, events); err != nil {
+			return err
+		}
+		if err := attachEventMedia(ctx, queries, events); err != nil {
+			return err
+		}
+		if err := attachEventCollaborators(ctx, queries, events); err != nil {
+			return err
+		}
+		event = &events[0]
+		return notify(ctx, tx, ChannelEvents, strconv.FormatInt(id, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: transition event %d status: %w", id, err)
+	}
+	d.refreshPerformancesView(ctx)
+	d.enqueueGoogleCalendarSync(ctx, id)
+	if next == models.StatusPublished {
+		d.enqueueWebhookSocialPost(ctx, id)
+		d.enqueueNewsletterCampaign(ctx, id)
+	}
+	return event, nil
+}
@@ -0,0 +1,61 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetGoogleCalendarSync returns the Google Calendar sync record for the
+// given event, or pgx.ErrNoRows if the event has never been mirrored.
+func (d *DB) GetGoogleCalendarSync(ctx context.Context, eventID int64) (*models.GoogleCalendarSync, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetGoogleCalendarSync(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("db: get google calendar sync for event %d: %w", eventID, err)
+	}
+	return googleCalendarSyncFromRow(row), nil
+}
+
+// UpsertGoogleCalendarSync records the Google Calendar event id an event
+// was just mirrored to, creating the sync record on the first mirror and
+// refreshing synced_at on every one after that.
+func (d *DB) UpsertGoogleCalendarSync(ctx context.Context, eventID int64, googleEventID string) (*models.GoogleCalendarSync, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).UpsertGoogleCalendarSync(ctx, sqlc.UpsertGoogleCalendarSyncParams{
+		EventID:       eventID,
+		GoogleEventID: googleEventID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: upsert google calendar sync for event %d: %w", eventID, err)
+	}
+	return googleCalendarSyncFromRow(row), nil
+}
+
+// DeleteGoogleCalendarSync removes the sync record for the given event,
+// once its mirrored Google Calendar event has been deleted.
+func (d *DB) DeleteGoogleCalendarSync(ctx context.Context, eventID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteGoogleCalendarSync(ctx, eventID); err != nil {
+		return fmt.Errorf("db: delete google calendar sync for event %d: %w", eventID, err)
+	}
+	return nil
+}
+
+// googleCalendarSyncFromRow converts a generated sqlc row into the
+// models.GoogleCalendarSync the syncer deals in.
+func googleCalendarSyncFromRow(row sqlc.GoogleCalendarSync) *models.GoogleCalendarSync {
+	return &models.GoogleCalendarSync{
+		EventID:       row.EventID,
+		GoogleEventID: row.GoogleEventID,
+		SyncedAt:      models.DateTime(row.SyncedAt),
+	}
+}
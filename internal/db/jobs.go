@@ -0,0 +1,270 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Job statuses. A job cycles pending -> running -> done, or back to pending
+// on a retryable failure until attempts is exhausted, at which point it
+// becomes dead and sits for manual inspection.
+const (
+	JobPending = "pending"
+	JobRunning = "running"
+	JobDone    = "done"
+	JobDead    = "dead"
+)
+
+// JobKindGoogleCalendarSync identifies a job that reconciles one event's
+// Google Calendar mirror. It lives here, rather than in
+// internal/googlecalendar, so both the producer (this package's event
+// writes) and the consumer (googlecalendar.Syncer's registration in
+// cmd/api/main.go) can reference the same string without googlecalendar
+// having to be imported by db.
+const JobKindGoogleCalendarSync = "google_calendar_sync"
+
+// JobKindWebhookSocialPost identifies a job that posts a published event's
+// social payload to every configured webhook channel. It lives here for the
+// same reason JobKindGoogleCalendarSync does: the consumer (webhooks.Poster's
+// registration in cmd/api/main.go) imports db for its Store interface, so db
+// can't import back to define the constant next to the consumer instead.
+const JobKindWebhookSocialPost = "webhook_social_post"
+
+// JobKindStreamingMetadataFetch identifies a job that fetches provider
+// metadata (artwork, release date) for one recording streaming link. It
+// lives here for the same reason JobKindGoogleCalendarSync does: the
+// consumer (streamingmeta.Fetcher's registration in cmd/api/main.go) imports
+// db for its Store interface, so db can't import back to define the
+// constant next to the consumer instead.
+const JobKindStreamingMetadataFetch = "streaming_metadata_fetch"
+
+// JobKindVideoMetadataFetch identifies a job that fetches title, duration
+// and thumbnail for one event's video embed from the YouTube Data API. It
+// lives here for the same reason JobKindGoogleCalendarSync does: the
+// consumer (youtube.Fetcher's registration in cmd/api/main.go) imports db
+// for its Store interface, so db can't import back to define the constant
+// next to the consumer instead.
+const JobKindVideoMetadataFetch = "video_metadata_fetch"
+
+// JobKindNewsletterSubscriberSync identifies a job that pushes one
+// subscriber's address to the configured newsletter provider. It lives
+// here for the same reason JobKindGoogleCalendarSync does: the consumer
+// (newsletter.Syncer's registration in cmd/api/main.go) imports db for its
+// Store interface, so db can't import back to define the constant next to
+// the consumer instead.
+const JobKindNewsletterSubscriberSync = "newsletter_subscriber_sync"
+
+// JobKindNewsletterDraftCampaign identifies a job that drafts a newsletter
+// campaign for a newly published event. It lives here for the same reason
+// JobKindGoogleCalendarSync does.
+const JobKindNewsletterDraftCampaign = "newsletter_draft_campaign"
+
+// Job is one unit of background work. Payload is opaque JSON; only the
+// handler registered for Kind knows how to decode it.
+type Job struct {
+	ID          int64
+	Kind        string
+	Payload     []byte
+	Status      string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time
+	LockedAt    *time.Time
+	LockedBy    string
+	LastError   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+const jobColumns = `id, kind, payload, status, attempts, max_attempts, run_at, locked_at, locked_by, last_error, created_at, updated_at`
+
+// EnqueueJob inserts a new pending job. maxAttempts <= 0 falls back to 5.
+func (d *DB) EnqueueJob(ctx context.Context, kind string, payload []byte, maxAttempts int) (*Job, error) {
+	if maxAttempts <= 0 {
+		maxAttempts = 5
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	rows, err := d.pool.Query(ctx, `
+		INSERT INTO jobs (kind, payload, max_attempts) VALUES ($1, $2, $3)
+		RETURNING `+jobColumns, kind, payload, maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("db: enqueue %s job: %w", kind, err)
+	}
+	defer rows.Close()
+
+	job, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByName[Job])
+	if err != nil {
+		return nil, fmt.Errorf("db: enqueue %s job: %w", kind, err)
+	}
+	return job, nil
+}
+
+// ClaimJobs locks up to limit pending, due jobs for workerID using
+// SELECT ... FOR UPDATE SKIP LOCKED, so concurrent workers (in this process
+// or another) never claim the same job twice, and never block on rows
+// already claimed elsewhere.
+func (d *DB) ClaimJobs(ctx context.Context, workerID string, limit int) ([]Job, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	var claimed []Job
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, `
+			SELECT `+jobColumns+`
+			FROM jobs
+			WHERE status = 'pending' AND run_at <= now()
+			ORDER BY run_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED`, limit)
+		if err != nil {
+			return err
+		}
+		jobs, err := pgx.CollectRows(rows, pgx.RowToStructByName[Job])
+		if err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(jobs))
+		for i, j := range jobs {
+			ids[i] = j.ID
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE jobs
+			SET status = 'running', locked_at = now(), locked_by = $2, attempts = attempts + 1, updated_at = now()
+			WHERE id = ANY($1)`, ids, workerID); err != nil {
+			return err
+		}
+		for i := range jobs {
+			jobs[i].Status = JobRunning
+			jobs[i].Attempts++
+			jobs[i].LockedBy = workerID
+		}
+		claimed = jobs
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: claim jobs: %w", err)
+	}
+	return claimed, nil
+}
+
+// CompleteJob marks the job with the given id done.
+func (d *DB) CompleteJob(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if _, err := d.pool.Exec(ctx, `UPDATE jobs SET status = 'done', updated_at = now() WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("db: complete job %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListDeadJobs returns every job that has exhausted its attempts, most
+// recently dead-lettered first, for the admin dead-letter queue view.
+func (d *DB) ListDeadJobs(ctx context.Context) ([]Job, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := d.pool.Query(ctx, `
+		SELECT `+jobColumns+`
+		FROM jobs
+		WHERE status = '`+JobDead+`'
+		ORDER BY updated_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("db: list dead jobs: %w", err)
+	}
+	defer rows.Close()
+
+	jobs, err := pgx.CollectRows(rows, pgx.RowToStructByName[Job])
+	if err != nil {
+		return nil, fmt.Errorf("db: list dead jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// RetryJob puts the dead job with the given id back in the queue, due
+// immediately, with a clean slate: attempts reset to 0, so it gets the full
+// max_attempts again. It's a no-op, reported as an error, if the job isn't
+// currently dead.
+func (d *DB) RetryJob(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	tag, err := d.pool.Exec(ctx, `
+		UPDATE jobs
+		SET status = '`+JobPending+`', attempts = 0, run_at = now(), locked_at = NULL, locked_by = '', updated_at = now()
+		WHERE id = $1 AND status = '`+JobDead+`'`, id)
+	if err != nil {
+		return fmt.Errorf("db: retry job %d: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("db: retry job %d: not found or not dead", id)
+	}
+	return nil
+}
+
+// RetryDeadJobs requeues every dead job at once, returning how many were
+// affected, for the "retry all" bulk-recovery action.
+func (d *DB) RetryDeadJobs(ctx context.Context) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	tag, err := d.pool.Exec(ctx, `
+		UPDATE jobs
+		SET status = '`+JobPending+`', attempts = 0, run_at = now(), locked_at = NULL, locked_by = '', updated_at = now()
+		WHERE status = '`+JobDead+`'`)
+	if err != nil {
+		return 0, fmt.Errorf("db: retry dead jobs: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// DeleteJob permanently removes the dead job with the given id: the
+// "delete a poison message" recovery action. It's a no-op, reported as an
+// error, if the job isn't currently dead, so it can't be used to delete
+// jobs still in flight.
+func (d *DB) DeleteJob(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	tag, err := d.pool.Exec(ctx, `DELETE FROM jobs WHERE id = $1 AND status = '`+JobDead+`'`, id)
+	if err != nil {
+		return fmt.Errorf("db: delete job %d: %w", id, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("db: delete job %d: not found or not dead", id)
+	}
+	return nil
+}
+
+// FailJob records jobErr against the job with the given id and either
+// reschedules it for retry at now()+delay, or moves it to the dead state if
+// it has used up its attempts.
+func (d *DB) FailJob(ctx context.Context, id int64, jobErr error, delay time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	runAt := time.Now().Add(delay)
+	_, err := d.pool.Exec(ctx, `
+		UPDATE jobs
+		SET status = CASE WHEN attempts >= max_attempts THEN 'dead' ELSE 'pending' END,
+		    run_at = $2,
+		    locked_at = NULL,
+		    locked_by = '',
+		    last_error = $3,
+		    updated_at = now()
+		WHERE id = $1`, id, runAt, jobErr.Error())
+	if err != nil {
+		return fmt.Errorf("db: fail job %d: %w", id, err)
+	}
+	return nil
+}
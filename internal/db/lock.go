@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// AdvisoryLock is a session-scoped Postgres advisory lock held on a
+// connection pinned out of the pool. Advisory locks are tied to the
+// session that took them, so the same connection must be used to release
+// one that a pooled Exec call happened to land on.
+type AdvisoryLock struct {
+	conn *pgxpool.Conn
+	key  int64
+}
+
+// TryAdvisoryLock attempts to acquire the Postgres advisory lock identified
+// by key without blocking. If another session already holds it, ok is
+// false and lock is nil; there's nothing to release.
+func (d *DB) TryAdvisoryLock(ctx context.Context, key int64) (lock *AdvisoryLock, ok bool, err error) {
+	conn, err := d.pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("db: acquire connection for advisory lock %d: %w", key, err)
+	}
+
+	var acquired bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, key).Scan(&acquired); err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("db: try advisory lock %d: %w", key, err)
+	}
+	if !acquired {
+		conn.Release()
+		return nil, false, nil
+	}
+	return &AdvisoryLock{conn: conn, key: key}, true, nil
+}
+
+// Release unlocks l and returns its connection to the pool. It must be
+// called exactly once, however TryAdvisoryLock's caller is done with the
+// locked work.
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	defer l.conn.Release()
+
+	if _, err := l.conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, l.key); err != nil {
+		return fmt.Errorf("db: release advisory lock %d: %w", l.key, err)
+	}
+	return nil
+}
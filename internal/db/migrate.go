@@ -0,0 +1,233 @@
+package db
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrationFS embed.FS
+
+// Migration is one embedded schema change, identified by a numeric version
+// prefix so ordering doesn't depend on filesystem listing order.
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// LoadMigrations returns every embedded migration, sorted by version.
+// Exported so ntumiwactl's migrate subcommands can inspect and print
+// pending SQL without duplicating how the embedded tree is parsed.
+func LoadMigrations() ([]Migration, error) {
+	entries, err := fs.ReadDir(migrationFS, "migrations")
+	if err != nil {
+		return nil, fmt.Errorf("db: read migrations dir: %w", err)
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, e := range entries {
+		name := e.Name()
+		version, rest, ok := parseMigrationFilename(name)
+		if !ok {
+			continue
+		}
+		b, err := migrationFS.ReadFile("migrations/" + name)
+		if err != nil {
+			return nil, fmt.Errorf("db: read migration %s: %w", name, err)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version}
+			byVersion[version] = m
+		}
+		if strings.HasSuffix(rest, ".up.sql") {
+			m.Name = strings.TrimSuffix(rest, ".up.sql")
+			m.Up = string(b)
+		} else if strings.HasSuffix(rest, ".down.sql") {
+			m.Down = string(b)
+		}
+	}
+
+	out := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		out = append(out, *m)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Version < out[j].Version })
+	return out, nil
+}
+
+// parseMigrationFilename splits "0001_initial.up.sql" into (1, "0001_initial.up.sql", true).
+func parseMigrationFilename(name string) (version int, rest string, ok bool) {
+	underscore := strings.IndexByte(name, '_')
+	if underscore < 0 {
+		return 0, "", false
+	}
+	v, err := strconv.Atoi(name[:underscore])
+	if err != nil {
+		return 0, "", false
+	}
+	return v, name, true
+}
+
+const createMigrationsTable = `
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version     INTEGER PRIMARY KEY,
+	name        TEXT NOT NULL,
+	applied_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+)`
+
+// currentVersion returns the highest applied migration version, or 0 if
+// schema_migrations doesn't exist yet or is empty. It never creates the
+// table, so it's safe to call from read-only paths like Status and the
+// --dry-run flag.
+func (d *DB) currentVersion(ctx context.Context) (int, error) {
+	var exists bool
+	if err := d.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM information_schema.tables WHERE table_name = 'schema_migrations')`).Scan(&exists); err != nil {
+		return 0, fmt.Errorf("db: check schema_migrations: %w", err)
+	}
+	if !exists {
+		return 0, nil
+	}
+	var current int
+	if err := d.pool.QueryRow(ctx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current); err != nil {
+		return 0, fmt.Errorf("db: read schema version: %w", err)
+	}
+	return current, nil
+}
+
+// PendingUp returns every embedded migration newer than the schema's
+// current version, in the order Migrate would apply them.
+func (d *DB) PendingUp(ctx context.Context) ([]Migration, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	current, err := d.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > current {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// PendingDown returns the most recently applied migration, the one
+// MigrateDown would roll back, or nil if none has been applied yet.
+func (d *DB) PendingDown(ctx context.Context) (*Migration, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	current, err := d.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if current == 0 {
+		return nil, nil
+	}
+	for _, m := range migrations {
+		if m.Version == current {
+			return &m, nil
+		}
+	}
+	return nil, fmt.Errorf("db: no migration found for version %d", current)
+}
+
+// Status reports every embedded migration and whether it's currently
+// applied to the connected database.
+func (d *DB) Status(ctx context.Context) ([]MigrationStatus, error) {
+	migrations, err := LoadMigrations()
+	if err != nil {
+		return nil, err
+	}
+	current, err := d.currentVersion(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, len(migrations))
+	for i, m := range migrations {
+		statuses[i] = MigrationStatus{Version: m.Version, Name: m.Name, Applied: m.Version <= current}
+	}
+	return statuses, nil
+}
+
+// MigrationStatus describes one embedded migration's applied state.
+type MigrationStatus struct {
+	Version int
+	Name    string
+	Applied bool
+}
+
+// Migrate applies every embedded migration newer than the schema's current
+// version, in order, recording each one as it lands.
+func (d *DB) Migrate(ctx context.Context) error {
+	if _, err := d.pool.Exec(ctx, createMigrationsTable); err != nil {
+		return fmt.Errorf("db: create schema_migrations: %w", err)
+	}
+
+	pending, err := d.PendingUp(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range pending {
+		tx, err := d.pool.Begin(ctx)
+		if err != nil {
+			return fmt.Errorf("db: migrate %04d_%s: begin: %w", m.Version, m.Name, err)
+		}
+		if _, err := tx.Exec(ctx, m.Up); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("db: migrate %04d_%s: %w", m.Version, m.Name, err)
+		}
+		if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name); err != nil {
+			tx.Rollback(ctx)
+			return fmt.Errorf("db: migrate %04d_%s: record version: %w", m.Version, m.Name, err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return fmt.Errorf("db: migrate %04d_%s: commit: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts the most recently applied migration.
+func (d *DB) MigrateDown(ctx context.Context) error {
+	m, err := d.PendingDown(ctx)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return nil
+	}
+	if m.Down == "" {
+		return fmt.Errorf("db: migration %04d_%s has no down script", m.Version, m.Name)
+	}
+
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("db: rollback %04d_%s: begin: %w", m.Version, m.Name, err)
+	}
+	if _, err := tx.Exec(ctx, m.Down); err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("db: rollback %04d_%s: %w", m.Version, m.Name, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM schema_migrations WHERE version = $1`, m.Version); err != nil {
+		tx.Rollback(ctx)
+		return fmt.Errorf("db: rollback %04d_%s: forget version: %w", m.Version, m.Name, err)
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+)
+
+// HasNewsletterCampaign reports whether a draft campaign has already been
+// created for the given event, so a newsletter.Syncer doesn't draft a
+// second campaign on every subsequent update to an already-published
+// event.
+func (d *DB) HasNewsletterCampaign(ctx context.Context, eventID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	drafted, err := sqlc.New(logged(ctx, d.reader())).HasNewsletterCampaign(ctx, eventID)
+	if err != nil {
+		return false, fmt.Errorf("db: has newsletter campaign for event %d: %w", eventID, err)
+	}
+	return drafted, nil
+}
+
+// RecordNewsletterCampaign marks the given event as having had a draft
+// campaign created, identified by the provider's campaignID, so it isn't
+// drafted again.
+func (d *DB) RecordNewsletterCampaign(ctx context.Context, eventID int64, campaignID string) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).RecordNewsletterCampaign(ctx, sqlc.RecordNewsletterCampaignParams{
+		EventID:    eventID,
+		CampaignID: campaignID,
+	}); err != nil {
+		return fmt.Errorf("db: record newsletter campaign for event %d: %w", eventID, err)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Notification channels used for cache invalidation. Payloads are the
+// mutated row's id, or empty for a bulk change.
+const (
+	ChannelEvents     = "ntumiwa_events"
+	ChannelProgrammes = "ntumiwa_programmes"
+)
+
+// execer is satisfied by both *pgxpool.Pool and pgx.Tx, so notify can be
+// called either standalone or as part of a transaction that's about to
+// commit.
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+}
+
+// notify sends a NOTIFY on channel with payload. Called with a pgx.Tx, the
+// notification only becomes visible to listeners once the transaction
+// commits.
+func notify(ctx context.Context, q execer, channel, payload string) error {
+	_, err := q.Exec(ctx, `SELECT pg_notify($1, $2)`, channel, payload)
+	return err
+}
+
+// Listen opens a dedicated connection and subscribes to channel, returning a
+// channel of notification payloads. The returned channel is closed, and the
+// connection released, when ctx is done or the connection is lost.
+func (d *DB) Listen(ctx context.Context, channel string) (<-chan string, error) {
+	conn, err := d.pool.Acquire(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: listen %s: acquire connection: %w", channel, err)
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		conn.Release()
+		return nil, fmt.Errorf("db: listen %s: %w", channel, err)
+	}
+
+	out := make(chan string)
+	go func() {
+		defer conn.Release()
+		defer close(out)
+		for {
+			n, err := conn.Conn().WaitForNotification(ctx)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- n.Payload:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package db
+
+import "github.com/adamkadda/ntumiwa/internal/models"
+
+// applyOptional merges opt onto current for a nullable column: absent
+// leaves current as-is, null clears it, and a value replaces it.
+func applyOptional[T any](opt models.Optional[T], current *T) *T {
+	if !opt.Set {
+		return current
+	}
+	if opt.Null {
+		return nil
+	}
+	return &opt.Value
+}
+
+// applyOptionalValue merges opt onto current for a non-nullable column
+// whose zero value stands in for "cleared": absent leaves current as-is,
+// null resets it to the zero value, and a value replaces it.
+func applyOptionalValue[T any](opt models.Optional[T], current T) T {
+	if !opt.Set {
+		return current
+	}
+	if opt.Null {
+		var zero T
+		return zero
+	}
+	return opt.Value
+}
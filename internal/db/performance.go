@@ -0,0 +1,120 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// PerformanceRow is one row of the public performances listing: a
+// published event flattened with its venue name, ticket offers, media and
+// guest collaborators, ready to render without joining events, venues and
+// programmes on every request. TourID and TourName are nil for a
+// performance that isn't part of a tour; when set, they let a client group
+// performances into their parent tour without a second request. SeasonID
+// and SeasonName work the same way for the season the event's date falls
+// in, if any. Status is always StatusPublished or StatusCancelled, the
+// only two the view's WHERE clause admits; CancellationReason is only
+// non-empty alongside StatusCancelled, so the public listing can show a
+// "CANCELLED" badge with why instead of the event just disappearing.
+type PerformanceRow struct {
+	ID                 int64
+	Title              string
+	Venue              string
+	EventDate          time.Time
+	TourID             *int64
+	TourName           *string
+	SeasonID           *int64
+	SeasonName         *string
+	Status             models.EventStatus
+	CancellationReason string
+	TicketOffers       []models.TicketOffer
+	Media              []models.EventMedia
+	Collaborators      []models.EventCollaborator
+}
+
+// ListPerformances returns every public, non-deleted event that is published
+// or cancelled from the performances_view materialized view, ordered by date,
+// with each row's
+// ticket offers, media and collaborators attached via batched queries: the
+// view itself stays event-only so a refresh doesn't have to re-derive
+// one-to-many offer, media or collaborator data.
+func (d *DB) ListPerformances(ctx context.Context) ([]PerformanceRow, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListPerformances(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list performances: %w", err)
+	}
+
+	ids := make([]int64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	offersByEvent := make(map[int64][]models.TicketOffer, len(rows))
+	mediaByEvent := make(map[int64][]models.EventMedia, len(rows))
+	collaboratorsByEvent := make(map[int64][]models.EventCollaborator, len(rows))
+	if len(ids) > 0 {
+		offerRows, err := q.ListTicketOffersByEventIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("db: list performances: %w", err)
+		}
+		for _, row := range offerRows {
+			offersByEvent[row.EventID] = append(offersByEvent[row.EventID], ticketOfferFromRow(row))
+		}
+
+		mediaRows, err := q.ListEventMediaByEventIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("db: list performances: %w", err)
+		}
+		for _, row := range mediaRows {
+			mediaByEvent[row.EventID] = append(mediaByEvent[row.EventID], eventMediaFromRow(row))
+		}
+
+		collaboratorRows, err := q.ListEventCollaboratorsByEventIDs(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("db: list performances: %w", err)
+		}
+		for _, row := range collaboratorRows {
+			collaboratorsByEvent[row.EventID] = append(collaboratorsByEvent[row.EventID], eventCollaboratorFromRow(row))
+		}
+	}
+
+	performances := make([]PerformanceRow, len(rows))
+	for i, row := range rows {
+		performances[i] = PerformanceRow{
+			ID:                 row.ID,
+			Title:              row.Title,
+			Venue:              row.Venue,
+			EventDate:          row.EventDate,
+			TourID:             row.TourID,
+			TourName:           row.TourName,
+			SeasonID:           row.SeasonID,
+			SeasonName:         row.SeasonName,
+			Status:             models.EventStatus(row.Status),
+			CancellationReason: row.CancellationReason,
+			TicketOffers:       offersByEvent[row.ID],
+			Media:              mediaByEvent[row.ID],
+			Collaborators:      collaboratorsByEvent[row.ID],
+		}
+	}
+	return performances, nil
+}
+
+// refreshPerformancesView rebuilds performances_view from the current
+// events and venues data. REFRESH MATERIALIZED VIEW CONCURRENTLY can't run
+// inside a transaction, so it always runs against the primary pool after
+// the triggering write has committed. It's best-effort: a failed refresh
+// just leaves the public listing stale until the next successful one,
+// rather than failing the write that triggered it.
+func (d *DB) refreshPerformancesView(ctx context.Context) {
+	if err := sqlc.New(logged(ctx, d.pool)).RefreshPerformancesView(ctx); err != nil {
+		log.Printf("db: refresh performances view: %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+)
+
+// EventsPerYear is the count of public, published events in a single
+// calendar year.
+type EventsPerYear struct {
+	Year  int   `json:"year"`
+	Count int64 `json:"count"`
+}
+
+// ComposerCount is how many public, published events performed at least
+// one piece by a composer.
+type ComposerCount struct {
+	ComposerID int64  `json:"composer_id"`
+	Name       string `json:"name"`
+	Count      int64  `json:"count"`
+}
+
+// PieceCount is how many public, published events performed a piece.
+type PieceCount struct {
+	PieceID int64  `json:"piece_id"`
+	Title   string `json:"title"`
+	Count   int64  `json:"count"`
+}
+
+// VenueCountryCount is how many public, published events took place at a
+// venue in a given country.
+type VenueCountryCount struct {
+	Country string `json:"country"`
+	Count   int64  `json:"count"`
+}
+
+// PerformanceStats is the set of aggregates behind GET /stats/performances:
+// events per year, the most-performed composers and pieces, and venues by
+// country. Every aggregate is scoped to public, published, non-deleted
+// events: the published subset of what performances_view exposes, so
+// cancelled events a visitor can see on the site are not counted.
+type PerformanceStats struct {
+	EventsPerYear   []EventsPerYear     `json:"events_per_year"`
+	TopComposers    []ComposerCount     `json:"top_composers"`
+	TopPieces       []PieceCount        `json:"top_pieces"`
+	VenuesByCountry []VenueCountryCount `json:"venues_by_country"`
+}
+
+// PerformanceStats computes the aggregates behind GET /stats/performances.
+// It runs four grouped queries against the primary schema rather than
+// performances_view, since the view deliberately carries no composer,
+// piece or country data to attach. It uses the Report timeout class, like
+// Export, since it scans every published event rather than a bounded page
+// of them.
+func (d *DB) PerformanceStats(ctx context.Context) (*PerformanceStats, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Report)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+
+	yearRows, err := q.CountPublicEventsByYear(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: performance stats: %w", err)
+	}
+	eventsPerYear := make([]EventsPerYear, len(yearRows))
+	for i, row := range yearRows {
+		eventsPerYear[i] = EventsPerYear{Year: int(row.Year), Count: row.Count}
+	}
+
+	composerRows, err := q.TopComposersByPublicEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: performance stats: %w", err)
+	}
+	topComposers := make([]ComposerCount, len(composerRows))
+	for i, row := range composerRows {
+		topComposers[i] = ComposerCount{ComposerID: row.ComposerID, Name: row.Name, Count: row.Count}
+	}
+
+	pieceRows, err := q.TopPiecesByPublicEvents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: performance stats: %w", err)
+	}
+	topPieces := make([]PieceCount, len(pieceRows))
+	for i, row := range pieceRows {
+		topPieces[i] = PieceCount{PieceID: row.PieceID, Title: row.Title, Count: row.Count}
+	}
+
+	countryRows, err := q.PublicEventsByVenueCountry(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: performance stats: %w", err)
+	}
+	venuesByCountry := make([]VenueCountryCount, len(countryRows))
+	for i, row := range countryRows {
+		venuesByCountry[i] = VenueCountryCount{Country: row.Country, Count: row.Count}
+	}
+
+	return &PerformanceStats{
+		EventsPerYear:   eventsPerYear,
+		TopComposers:    topComposers,
+		TopPieces:       topPieces,
+		VenuesByCountry: venuesByCountry,
+	}, nil
+}
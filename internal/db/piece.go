@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetPiece returns the piece with the given id.
+func (d *DB) GetPiece(ctx context.Context, id int64) (*models.Piece, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	piece, err := sqlc.New(logged(ctx, d.reader())).GetPiece(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get piece %d: %w", id, err)
+	}
+	return pieceFromRow(piece), nil
+}
+
+// ListPieces returns every piece in the catalogue.
+func (d *DB) ListPieces(ctx context.Context) ([]models.Piece, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListPieces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list pieces: %w", err)
+	}
+
+	pieces := make([]models.Piece, len(rows))
+	for i, row := range rows {
+		pieces[i] = *pieceFromRow(row)
+	}
+	return pieces, nil
+}
+
+// CreatePiece inserts a new piece from req. If req.Composer is set, a new
+// composer is inserted in the same transaction and credited in place of
+// req.ComposerID, so a piece by a composer who isn't in the catalogue yet
+// doesn't require a separate create-composer call first.
+func (d *DB) CreatePiece(ctx context.Context, req models.PieceRequest) (*models.Piece, error) {
+	if len(req.Title) < 1 {
+		return nil, fmt.Errorf("db: create piece: title is required")
+	}
+	composerReq, hasComposerReq := req.Composer.Get()
+	composerID, hasComposerID := req.ComposerID.Get()
+	if !hasComposerReq && !hasComposerID {
+		return nil, fmt.Errorf("db: create piece: composer_id or composer is required")
+	}
+	if hasComposerReq && len(composerReq.Name) < 1 {
+		return nil, fmt.Errorf("db: create piece: composer name is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	var piece sqlc.Piece
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+		if hasComposerReq {
+			composer, err := q.CreateComposer(ctx, composerReq.Name)
+			if err != nil {
+				return err
+			}
+			composerID = composer.ID
+		}
+		var err error
+		piece, err = q.CreatePiece(ctx, sqlc.CreatePieceParams{
+			Title:      req.Title,
+			ComposerID: composerID,
+			CreatedBy:  actor,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create piece: %w", err)
+	}
+	return pieceFromRow(piece), nil
+}
+
+// DeletePiece moves the piece with the given id to the trash.
+func (d *DB) DeletePiece(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeletePiece(ctx, id); err != nil {
+		return fmt.Errorf("db: delete piece %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedPieces returns every piece currently in the trash.
+func (d *DB) ListTrashedPieces(ctx context.Context) ([]models.Piece, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTrashedPieces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed pieces: %w", err)
+	}
+
+	pieces := make([]models.Piece, len(rows))
+	for i, row := range rows {
+		pieces[i] = *pieceFromRow(row)
+	}
+	return pieces, nil
+}
+
+// RestorePiece takes the piece with the given id out of the trash.
+func (d *DB) RestorePiece(ctx context.Context, id int64) (*models.Piece, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	piece, err := sqlc.New(logged(ctx, d.pool)).RestorePiece(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore piece %d: %w", id, err)
+	}
+	return pieceFromRow(piece), nil
+}
+
+// pieceFromRow converts a generated sqlc row into the models.Piece the API
+// layer deals in.
+func pieceFromRow(row sqlc.Piece) *models.Piece {
+	return &models.Piece{
+		ID:         row.ID,
+		Title:      row.Title,
+		ComposerID: row.ComposerID,
+		CreatedBy:  row.CreatedBy,
+		UpdatedBy:  row.UpdatedBy,
+		CreatedAt:  models.DateTime(row.CreatedAt),
+		UpdatedAt:  models.DateTime(row.UpdatedAt),
+		DeletedAt:  deletedAt(row.DeletedAt),
+	}
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetProfile returns the profile with the given id.
+func (d *DB) GetProfile(ctx context.Context, id int64) (*models.Profile, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	profile, err := sqlc.New(logged(ctx, d.reader())).GetProfile(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get profile %d: %w", id, err)
+	}
+	return profileFromRow(profile), nil
+}
+
+// GetProfileBySlug returns the profile with the given slug.
+func (d *DB) GetProfileBySlug(ctx context.Context, slug string) (*models.Profile, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	profile, err := sqlc.New(logged(ctx, d.reader())).GetProfileBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("db: get profile by slug %q: %w", slug, err)
+	}
+	return profileFromRow(profile), nil
+}
+
+// ListProfiles returns every profile, ordered by name.
+func (d *DB) ListProfiles(ctx context.Context) ([]models.Profile, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListProfiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list profiles: %w", err)
+	}
+
+	profiles := make([]models.Profile, len(rows))
+	for i, row := range rows {
+		profiles[i] = *profileFromRow(row)
+	}
+	return profiles, nil
+}
+
+// CreateProfile inserts a new profile. slug must be unique; it's how
+// events and other content tables are scoped to this profile, and how a
+// future public endpoint would address it in a URL.
+func (d *DB) CreateProfile(ctx context.Context, slug, name string) (*models.Profile, error) {
+	if len(slug) < 1 || len(name) < 1 {
+		return nil, fmt.Errorf("db: create profile: slug and name are required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	profile, err := sqlc.New(logged(ctx, d.pool)).CreateProfile(ctx, sqlc.CreateProfileParams{
+		Slug: slug,
+		Name: name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create profile: %w", err)
+	}
+	return profileFromRow(profile), nil
+}
+
+// ListEventsByProfile returns every event belonging to the given profile.
+func (d *DB) ListEventsByProfile(ctx context.Context, profileID int64) ([]models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListEventsByProfileID(ctx, &profileID)
+	if err != nil {
+		return nil, fmt.Errorf("db: list events by profile %d: %w", profileID, err)
+	}
+
+	events := make([]models.Event, len(rows))
+	for i, row := range rows {
+		events[i] = *eventFromRow(row)
+	}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by profile %d: %w", profileID, err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by profile %d: %w", profileID, err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by profile %d: %w", profileID, err)
+	}
+	return events, nil
+}
+
+// profileFromRow converts a generated sqlc row into the models.Profile
+// the API layer deals in.
+func profileFromRow(row sqlc.Profile) *models.Profile {
+	return &models.Profile{
+		ID:        row.ID,
+		Slug:      row.Slug,
+		Name:      row.Name,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,188 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetProgramme returns the programme with the given id.
+func (d *DB) GetProgramme(ctx context.Context, id int64) (*models.Programme, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	programme, err := q.GetProgramme(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get programme %d: %w", id, err)
+	}
+	programmes := []models.Programme{*programmeFromRow(programme)}
+	if err := attachProgrammePieces(ctx, q, programmes); err != nil {
+		return nil, fmt.Errorf("db: get programme %d: %w", id, err)
+	}
+	return &programmes[0], nil
+}
+
+// ListProgrammes returns every programme.
+func (d *DB) ListProgrammes(ctx context.Context) ([]models.Programme, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListProgrammes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list programmes: %w", err)
+	}
+
+	programmes := make([]models.Programme, len(rows))
+	for i, row := range rows {
+		programmes[i] = *programmeFromRow(row)
+	}
+	if err := attachProgrammePieces(ctx, q, programmes); err != nil {
+		return nil, fmt.Errorf("db: list programmes: %w", err)
+	}
+	return programmes, nil
+}
+
+// CreateProgramme inserts a new programme.
+func (d *DB) CreateProgramme(ctx context.Context, title string) (*models.Programme, error) {
+	if len(title) < 1 {
+		return nil, fmt.Errorf("db: create programme: title is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+
+	var programme *models.Programme
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		row, err := sqlc.New(logged(ctx, tx)).CreateProgramme(ctx, sqlc.CreateProgrammeParams{
+			Title:     title,
+			CreatedBy: actor,
+		})
+		if err != nil {
+			return err
+		}
+		programme = programmeFromRow(row)
+		return notify(ctx, tx, ChannelProgrammes, strconv.FormatInt(programme.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create programme: %w", err)
+	}
+	return programme, nil
+}
+
+// UpdateProgramme renames the programme with the given id.
+func (d *DB) UpdateProgramme(ctx context.Context, id int64, title string) (*models.Programme, error) {
+	if len(title) < 1 {
+		return nil, fmt.Errorf("db: update programme: title is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+
+	var programme *models.Programme
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		row, err := sqlc.New(logged(ctx, tx)).UpdateProgramme(ctx, sqlc.UpdateProgrammeParams{
+			ID:        id,
+			Title:     title,
+			UpdatedBy: actor,
+		})
+		if err != nil {
+			return err
+		}
+		programme = programmeFromRow(row)
+		return notify(ctx, tx, ChannelProgrammes, strconv.FormatInt(programme.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update programme %d: %w", id, err)
+	}
+	return programme, nil
+}
+
+// DeleteProgramme moves the programme with the given id to the trash.
+func (d *DB) DeleteProgramme(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := sqlc.New(logged(ctx, tx)).DeleteProgramme(ctx, id); err != nil {
+			return err
+		}
+		return notify(ctx, tx, ChannelProgrammes, strconv.FormatInt(id, 10))
+	})
+	if err != nil {
+		return fmt.Errorf("db: delete programme %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedProgrammes returns every programme currently in the trash.
+func (d *DB) ListTrashedProgrammes(ctx context.Context) ([]models.Programme, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListTrashedProgrammes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed programmes: %w", err)
+	}
+
+	programmes := make([]models.Programme, len(rows))
+	for i, row := range rows {
+		programmes[i] = *programmeFromRow(row)
+	}
+	if err := attachProgrammePieces(ctx, q, programmes); err != nil {
+		return nil, fmt.Errorf("db: list trashed programmes: %w", err)
+	}
+	return programmes, nil
+}
+
+// RestoreProgramme takes the programme with the given id out of the trash.
+func (d *DB) RestoreProgramme(ctx context.Context, id int64) (*models.Programme, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	var programme *models.Programme
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+		row, err := q.RestoreProgramme(ctx, id)
+		if err != nil {
+			return err
+		}
+		programmes := []models.Programme{*programmeFromRow(row)}
+		if err := attachProgrammePieces(ctx, q, programmes); err != nil {
+			return err
+		}
+		programme = &programmes[0]
+		return notify(ctx, tx, ChannelProgrammes, strconv.FormatInt(programme.ID, 10))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: restore programme %d: %w", id, err)
+	}
+	return programme, nil
+}
+
+// programmeFromRow converts a generated sqlc row into the models.Programme
+// the API layer deals in. Pieces is left nil; callers attach it separately
+// with attachProgrammePieces since it isn't a column on this row.
+func programmeFromRow(row sqlc.Programme) *models.Programme {
+	return &models.Programme{
+		ID:        row.ID,
+		Title:     row.Title,
+		CreatedBy: row.CreatedBy,
+		UpdatedBy: row.UpdatedBy,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+		DeletedAt: deletedAt(row.DeletedAt),
+	}
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// programmePieceFromRow converts a generated sqlc row into the
+// models.ProgrammePiece the API layer deals in.
+func programmePieceFromRow(row sqlc.ProgrammePiece) models.ProgrammePiece {
+	return models.ProgrammePiece{
+		ID:        row.ID,
+		PieceID:   row.PieceID,
+		Position:  int(row.Position),
+		Notes:     row.Notes,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachProgrammePieces fills in Pieces on every programme in place with a
+// single batched query, rather than one query per programme.
+func attachProgrammePieces(ctx context.Context, q *sqlc.Queries, programmes []models.Programme) error {
+	if len(programmes) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(programmes))
+	byID := make(map[int64]*models.Programme, len(programmes))
+	for i := range programmes {
+		ids[i] = programmes[i].ID
+		byID[programmes[i].ID] = &programmes[i]
+	}
+
+	rows, err := q.ListProgrammePiecesByProgrammeIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list programme pieces: %w", err)
+	}
+	for _, row := range rows {
+		programme, ok := byID[row.ProgrammeID]
+		if !ok {
+			continue
+		}
+		programme.Pieces = append(programme.Pieces, programmePieceFromRow(row))
+	}
+	return nil
+}
+
+// SetProgrammePieces replaces the running order of the programme with the
+// given id: every existing entry is deleted and reqs is inserted in order,
+// so the position of each piece is simply its index in reqs. There's no
+// way to patch a single piece or reorder without resending the whole
+// list, the same full-replace tradeoff EventRequest.TicketOffers makes.
+func (d *DB) SetProgrammePieces(ctx context.Context, programmeID int64, reqs []models.ProgrammePieceRequest) ([]models.ProgrammePiece, error) {
+	for i, req := range reqs {
+		if err := validate.Struct(req); err != nil {
+			return nil, fmt.Errorf("db: set programme pieces: piece %d: %w", i, err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	var pieces []models.ProgrammePiece
+	err := d.WithTx(ctx, func(tx pgx.Tx) error {
+		q := sqlc.New(logged(ctx, tx))
+
+		if err := q.DeleteProgrammePieces(ctx, programmeID); err != nil {
+			return fmt.Errorf("delete existing programme pieces: %w", err)
+		}
+
+		pieces = make([]models.ProgrammePiece, len(reqs))
+		for i, req := range reqs {
+			row, err := q.CreateProgrammePiece(ctx, sqlc.CreateProgrammePieceParams{
+				ProgrammeID: programmeID,
+				PieceID:     req.PieceID,
+				Position:    int32(i),
+				Notes:       req.Notes,
+			})
+			if err != nil {
+				return fmt.Errorf("create programme piece: %w", err)
+			}
+			pieces[i] = programmePieceFromRow(row)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: set programme pieces %d: %w", programmeID, err)
+	}
+	return pieces, nil
+}
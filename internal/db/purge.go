@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// trashedTables lists the soft-deleted tables the purge hard-deletes from.
+// Tours, seasons, recordings and webhook channels are soft-deleted too but
+// aren't listed, so their trashed rows are never purged. It's a fixed, compile-time list, not
+// user input, so building the
+// DELETE statement with fmt.Sprintf here is safe even though queries
+// elsewhere in this package bind values as parameters instead.
+var trashedTables = []string{"events", "programmes", "pieces", "composers", "venues"}
+
+// PurgeExpired hard-deletes rows that have been in the trash longer than
+// retention, across every table in trashedTables. It returns the total
+// number of rows removed.
+func (d *DB) PurgeExpired(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	cutoff := time.Now().Add(-retention)
+
+	var total int64
+	for _, table := range trashedTables {
+		tag, err := d.pool.Exec(ctx, fmt.Sprintf(`DELETE FROM %s WHERE deleted_at IS NOT NULL AND deleted_at < $1`, table), cutoff)
+		if err != nil {
+			return total, fmt.Errorf("db: purge %s: %w", table, err)
+		}
+		total += tag.RowsAffected()
+	}
+	return total, nil
+}
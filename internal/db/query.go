@@ -0,0 +1,39 @@
+package db
+
+import (
+	"strconv"
+	"strings"
+)
+
+// queryBuilder accumulates parameterized SQL fragments and their bind
+// values, so filters and updates built up conditionally never fall back to
+// string-interpolating a value into the query text.
+type queryBuilder struct {
+	args []interface{}
+}
+
+// bind appends v to the argument list and returns its placeholder, e.g. "$1".
+func (b *queryBuilder) bind(v interface{}) string {
+	b.args = append(b.args, v)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// Args returns the accumulated bind values, in placeholder order.
+func (b *queryBuilder) Args() []interface{} {
+	return b.args
+}
+
+// whereClause joins non-empty conditions with AND, prefixed with "WHERE ".
+// It returns "" if there are no conditions.
+func whereClause(conditions ...string) string {
+	var kept []string
+	for _, c := range conditions {
+		if c != "" {
+			kept = append(kept, c)
+		}
+	}
+	if len(kept) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(kept, " AND ")
+}
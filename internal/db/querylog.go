@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+)
+
+type debugLogKey struct{}
+
+// WithDebugLog flags ctx so every query run through it is logged, for the
+// lifetime of the request it belongs to. It's set by debugLogMiddleware,
+// never globally, so it never floods production logs on its own.
+func WithDebugLog(ctx context.Context) context.Context {
+	return context.WithValue(ctx, debugLogKey{}, true)
+}
+
+// DebugLogEnabled reports whether ctx was flagged by WithDebugLog.
+func DebugLogEnabled(ctx context.Context) bool {
+	v, _ := ctx.Value(debugLogKey{}).(bool)
+	return v
+}
+
+// logged wraps dbtx so its queries are logged when ctx is flagged by
+// WithDebugLog, and returns dbtx unchanged otherwise.
+func logged(ctx context.Context, dbtx sqlc.DBTX) sqlc.DBTX {
+	if !DebugLogEnabled(ctx) {
+		return dbtx
+	}
+	return &loggingDBTX{dbtx: dbtx}
+}
+
+// loggingDBTX logs the SQL text and arguments of every query it runs
+// before delegating to the wrapped DBTX.
+type loggingDBTX struct {
+	dbtx sqlc.DBTX
+}
+
+func (l *loggingDBTX) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	log.Printf("db: debug: exec %s %v", sql, args)
+	return l.dbtx.Exec(ctx, sql, args...)
+}
+
+func (l *loggingDBTX) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	log.Printf("db: debug: query %s %v", sql, args)
+	return l.dbtx.Query(ctx, sql, args...)
+}
+
+func (l *loggingDBTX) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	log.Printf("db: debug: query %s %v", sql, args)
+	return l.dbtx.QueryRow(ctx, sql, args...)
+}
@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetRecording returns the recording with the given id.
+func (d *DB) GetRecording(ctx context.Context, id int64) (*models.Recording, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	row, err := q.GetRecording(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get recording %d: %w", id, err)
+	}
+	recordings := []models.Recording{*recordingFromRow(row)}
+	if err := attachRecordingStreamingLinks(ctx, q, recordings); err != nil {
+		return nil, fmt.Errorf("db: get recording %d: %w", id, err)
+	}
+	return &recordings[0], nil
+}
+
+// ListRecordings returns every recording in the discography.
+func (d *DB) ListRecordings(ctx context.Context) ([]models.Recording, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListRecordings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list recordings: %w", err)
+	}
+
+	recordings := make([]models.Recording, len(rows))
+	for i, row := range rows {
+		recordings[i] = *recordingFromRow(row)
+	}
+	if err := attachRecordingStreamingLinks(ctx, q, recordings); err != nil {
+		return nil, fmt.Errorf("db: list recordings: %w", err)
+	}
+	return recordings, nil
+}
+
+// CreateRecording inserts a new recording of pieceID.
+func (d *DB) CreateRecording(ctx context.Context, title string, pieceID int64, releaseDate *models.DateTime) (*models.Recording, error) {
+	if len(title) < 1 {
+		return nil, fmt.Errorf("db: create recording: title is required")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateRecording(ctx, sqlc.CreateRecordingParams{
+		Title:       title,
+		PieceID:     pieceID,
+		ReleaseDate: releaseDateArg(releaseDate),
+		CreatedBy:   actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create recording: %w", err)
+	}
+	return recordingFromRow(row), nil
+}
+
+// DeleteRecording moves the recording with the given id to the trash.
+func (d *DB) DeleteRecording(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteRecording(ctx, id); err != nil {
+		return fmt.Errorf("db: delete recording %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedRecordings returns every recording currently in the trash.
+func (d *DB) ListTrashedRecordings(ctx context.Context) ([]models.Recording, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListTrashedRecordings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed recordings: %w", err)
+	}
+
+	recordings := make([]models.Recording, len(rows))
+	for i, row := range rows {
+		recordings[i] = *recordingFromRow(row)
+	}
+	if err := attachRecordingStreamingLinks(ctx, q, recordings); err != nil {
+		return nil, fmt.Errorf("db: list trashed recordings: %w", err)
+	}
+	return recordings, nil
+}
+
+// RestoreRecording takes the recording with the given id out of the trash.
+func (d *DB) RestoreRecording(ctx context.Context, id int64) (*models.Recording, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.pool))
+	row, err := q.RestoreRecording(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore recording %d: %w", id, err)
+	}
+	recordings := []models.Recording{*recordingFromRow(row)}
+	if err := attachRecordingStreamingLinks(ctx, q, recordings); err != nil {
+		return nil, fmt.Errorf("db: restore recording %d: %w", id, err)
+	}
+	return &recordings[0], nil
+}
+
+// releaseDateArg converts an optional models.DateTime into the *time.Time a
+// nullable DATE column expects.
+func releaseDateArg(d *models.DateTime) *time.Time {
+	if d == nil {
+		return nil
+	}
+	t := d.Time()
+	return &t
+}
+
+// recordingFromRow converts a generated sqlc row into the models.Recording
+// the API layer deals in. StreamingLinks is left nil; callers attach it
+// separately with attachRecordingStreamingLinks since it isn't a column on
+// this row.
+func recordingFromRow(row sqlc.Recording) *models.Recording {
+	return &models.Recording{
+		ID:          row.ID,
+		Title:       row.Title,
+		PieceID:     row.PieceID,
+		ReleaseDate: deletedAt(row.ReleaseDate),
+		CreatedBy:   row.CreatedBy,
+		UpdatedBy:   row.UpdatedBy,
+		CreatedAt:   models.DateTime(row.CreatedAt),
+		UpdatedAt:   models.DateTime(row.UpdatedAt),
+		DeletedAt:   deletedAt(row.DeletedAt),
+	}
+}
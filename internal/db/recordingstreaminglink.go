@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// recordingStreamingLinkFromRow converts a generated sqlc row into the
+// models.RecordingStreamingLink the API layer deals in.
+func recordingStreamingLinkFromRow(row sqlc.RecordingStreamingLink) models.RecordingStreamingLink {
+	return models.RecordingStreamingLink{
+		ID:                  row.ID,
+		Provider:            row.Provider,
+		URL:                 row.Url,
+		ArtworkURL:          row.ArtworkUrl,
+		ProviderReleaseDate: deletedAt(row.ProviderReleaseDate),
+		MetadataFetchedAt:   deletedAt(row.MetadataFetchedAt),
+		CreatedAt:           models.DateTime(row.CreatedAt),
+		UpdatedAt:           models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachRecordingStreamingLinks fills in StreamingLinks on every recording in
+// place with a single batched query, rather than one query per recording.
+func attachRecordingStreamingLinks(ctx context.Context, q *sqlc.Queries, recordings []models.Recording) error {
+	if len(recordings) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(recordings))
+	byID := make(map[int64]*models.Recording, len(recordings))
+	for i := range recordings {
+		ids[i] = recordings[i].ID
+		byID[recordings[i].ID] = &recordings[i]
+	}
+
+	rows, err := q.ListRecordingStreamingLinksByRecordingIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list recording streaming links: %w", err)
+	}
+	for _, row := range rows {
+		recording, ok := byID[row.RecordingID]
+		if !ok {
+			continue
+		}
+		recording.StreamingLinks = append(recording.StreamingLinks, recordingStreamingLinkFromRow(row))
+	}
+	return nil
+}
+
+// GetRecordingStreamingLink returns the streaming link with the given id,
+// independent of which recording it belongs to. It exists for
+// streamingmeta.Fetcher, which only ever has a link id to work from.
+func (d *DB) GetRecordingStreamingLink(ctx context.Context, id int64) (*models.RecordingStreamingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetRecordingStreamingLink(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get recording streaming link %d: %w", id, err)
+	}
+	link := recordingStreamingLinkFromRow(row)
+	return &link, nil
+}
+
+// AddRecordingStreamingLink attaches a new provider link to the recording
+// with the given id and enqueues a best-effort job to fetch its provider
+// metadata (artwork, release date). Unlike Recording's own CRUD there's no
+// full-replace request struct for a link: it's a handful of plain arguments,
+// matching AddVenueMedia.
+func (d *DB) AddRecordingStreamingLink(ctx context.Context, recordingID int64, provider, url string) (*models.RecordingStreamingLink, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateRecordingStreamingLink(ctx, sqlc.CreateRecordingStreamingLinkParams{
+		RecordingID: recordingID,
+		Provider:    provider,
+		Url:         url,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: add recording streaming link: %w", err)
+	}
+	link := recordingStreamingLinkFromRow(row)
+	d.enqueueStreamingMetadataFetch(ctx, link.ID)
+	return &link, nil
+}
+
+// DeleteRecordingStreamingLink removes a single provider link from the
+// recording with the given id.
+func (d *DB) DeleteRecordingStreamingLink(ctx context.Context, recordingID, linkID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteRecordingStreamingLink(ctx, linkID, recordingID); err != nil {
+		return fmt.Errorf("db: delete recording streaming link %d: %w", linkID, err)
+	}
+	return nil
+}
+
+// UpdateRecordingStreamingLinkMetadata persists artworkURL and
+// providerReleaseDate fetched from the provider for the link with the given
+// id, and stamps metadata_fetched_at. It's called by streamingmeta.Fetcher,
+// never directly by API handlers.
+func (d *DB) UpdateRecordingStreamingLinkMetadata(ctx context.Context, id int64, artworkURL string, providerReleaseDate *models.DateTime) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	err := sqlc.New(logged(ctx, d.pool)).UpdateRecordingStreamingLinkMetadata(ctx, sqlc.UpdateRecordingStreamingLinkMetadataParams{
+		ID:                  id,
+		ArtworkUrl:          artworkURL,
+		ProviderReleaseDate: releaseDateArg(providerReleaseDate),
+	})
+	if err != nil {
+		return fmt.Errorf("db: update recording streaming link metadata %d: %w", id, err)
+	}
+	return nil
+}
+
+// enqueueStreamingMetadataFetchPayload is the outbox payload enqueued below:
+// just the link id, since streamingmeta.Fetcher re-fetches the link's
+// current state before acting on it.
+type enqueueStreamingMetadataFetchPayload struct {
+	LinkID int64 `json:"link_id"`
+}
+
+// enqueueStreamingMetadataFetch is a best-effort side effect: it schedules
+// the async provider-metadata fetch for a newly added streaming link, but
+// never fails the write that created it. A failure here just means the
+// fetch is missed until the link is next touched.
+func (d *DB) enqueueStreamingMetadataFetch(ctx context.Context, linkID int64) {
+	payload, err := json.Marshal(enqueueStreamingMetadataFetchPayload{LinkID: linkID})
+	if err != nil {
+		log.Printf("db: marshal streaming metadata fetch payload for link %d: %v", linkID, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindStreamingMetadataFetch, payload, 0); err != nil {
+		log.Printf("db: enqueue streaming metadata fetch for link %d: %v", linkID, err)
+	}
+}
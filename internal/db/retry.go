@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// RetryConfig controls how many times a transaction is retried after a
+// transient error, and how long it waits between attempts.
+type RetryConfig struct {
+	MaxAttempts int           // total attempts including the first, 0 disables retries
+	BaseDelay   time.Duration // delay before the first retry
+	MaxDelay    time.Duration // cap on backoff delay
+}
+
+func defaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 3,
+		BaseDelay:   20 * time.Millisecond,
+		MaxDelay:    500 * time.Millisecond,
+	}
+}
+
+// retryableCodes are the Postgres error codes worth retrying: serialization
+// failures and deadlocks from concurrent transactions, and the connection
+// hiccups that come with a brief failover.
+var retryableCodes = map[string]bool{
+	"40001": true, // serialization_failure
+	"40P01": true, // deadlock_detected
+	"08000": true, // connection_exception
+	"08003": true, // connection_does_not_exist
+	"08006": true, // connection_failure
+	"57P01": true, // admin_shutdown
+	"57P03": true, // cannot_connect_now
+}
+
+// isRetryable reports whether err is a transient database error worth
+// retrying, as opposed to a permanent one (bad query, constraint violation)
+// that would just fail the same way again.
+func isRetryable(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return retryableCodes[pgErr.Code]
+	}
+	return false
+}
+
+// backoff returns a jittered exponential delay for the given retry attempt
+// (1-indexed), capped at cfg.MaxDelay.
+func backoff(cfg RetryConfig, attempt int) time.Duration {
+	delay := cfg.BaseDelay << uint(attempt-1)
+	if delay > cfg.MaxDelay || delay <= 0 {
+		delay = cfg.MaxDelay
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
+
+// withRetry runs fn, retrying it with jittered backoff while it keeps
+// failing with a transient error, up to cfg.MaxAttempts.
+func withRetry(ctx context.Context, cfg RetryConfig, fn func() error) error {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= cfg.MaxAttempts; attempt++ {
+		if attempt > 1 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff(cfg, attempt-1)):
+			}
+		}
+
+		err = fn()
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+	}
+	return err
+}
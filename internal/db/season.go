@@ -0,0 +1,201 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// GetSeason returns the season with the given id.
+func (d *DB) GetSeason(ctx context.Context, id int64) (*models.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetSeason(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get season %d: %w", id, err)
+	}
+	return seasonFromRow(row), nil
+}
+
+// ListSeasons returns every season, ordered by start date.
+func (d *DB) ListSeasons(ctx context.Context) ([]models.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListSeasons(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list seasons: %w", err)
+	}
+
+	seasons := make([]models.Season, len(rows))
+	for i, row := range rows {
+		seasons[i] = *seasonFromRow(row)
+	}
+	return seasons, nil
+}
+
+// CreateSeason inserts a new season from req.
+func (d *DB) CreateSeason(ctx context.Context, req models.SeasonRequest) (*models.Season, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: create season: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateSeason(ctx, sqlc.CreateSeasonParams{
+		Name:      req.Name,
+		StartsOn:  req.StartsOn.Time(),
+		EndsOn:    req.EndsOn.Time(),
+		CreatedBy: actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create season: %w", err)
+	}
+	return seasonFromRow(row), nil
+}
+
+// UpdateSeason replaces the editable fields of the season with the given
+// id.
+func (d *DB) UpdateSeason(ctx context.Context, id int64, req models.SeasonRequest) (*models.Season, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: update season %d: %w", id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).UpdateSeason(ctx, sqlc.UpdateSeasonParams{
+		ID:        id,
+		Name:      req.Name,
+		StartsOn:  req.StartsOn.Time(),
+		EndsOn:    req.EndsOn.Time(),
+		UpdatedBy: actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update season %d: %w", id, err)
+	}
+	return seasonFromRow(row), nil
+}
+
+// DeleteSeason moves the season with the given id to the trash. Events
+// already assigned to it keep their SeasonID, and ListEventsBySeason still
+// returns them, since it filters on the events' season_id alone.
+func (d *DB) DeleteSeason(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteSeason(ctx, id); err != nil {
+		return fmt.Errorf("db: delete season %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedSeasons returns every season currently in the trash.
+func (d *DB) ListTrashedSeasons(ctx context.Context) ([]models.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTrashedSeasons(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed seasons: %w", err)
+	}
+
+	seasons := make([]models.Season, len(rows))
+	for i, row := range rows {
+		seasons[i] = *seasonFromRow(row)
+	}
+	return seasons, nil
+}
+
+// RestoreSeason takes the season with the given id out of the trash.
+func (d *DB) RestoreSeason(ctx context.Context, id int64) (*models.Season, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).RestoreSeason(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore season %d: %w", id, err)
+	}
+	return seasonFromRow(row), nil
+}
+
+// ListEventsBySeason returns every event assigned to the season with the
+// given id, ordered by date, fully hydrated the same way ListEvents
+// hydrates its results.
+func (d *DB) ListEventsBySeason(ctx context.Context, seasonID int64) ([]models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListEventsBySeasonID(ctx, &seasonID)
+	if err != nil {
+		return nil, fmt.Errorf("db: list events by season %d: %w", seasonID, err)
+	}
+
+	events := make([]models.Event, len(rows))
+	for i, row := range rows {
+		events[i] = *eventFromRow(row)
+	}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by season %d: %w", seasonID, err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by season %d: %w", seasonID, err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by season %d: %w", seasonID, err)
+	}
+	return events, nil
+}
+
+// resolveSeasonID picks the season an event should carry. An absent
+// SeasonID auto-assigns whichever season's date range covers eventDate;
+// an explicit value pins that season regardless of eventDate; an
+// explicit null clears the season entirely, opting the event out of
+// auto-assignment. It's not an error for no season to cover the date;
+// the event is simply left without one until a season is created or
+// extended to cover it.
+func resolveSeasonID(ctx context.Context, q *sqlc.Queries, explicit models.Optional[int64], eventDate time.Time) (*int64, error) {
+	if explicit.Set {
+		if explicit.Null {
+			return nil, nil
+		}
+		return &explicit.Value, nil
+	}
+	season, err := q.GetSeasonForDate(ctx, eventDate)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &season.ID, nil
+}
+
+// seasonFromRow converts a generated sqlc row into the models.Season the
+// API layer deals in.
+func seasonFromRow(row sqlc.Season) *models.Season {
+	return &models.Season{
+		ID:        row.ID,
+		Name:      row.Name,
+		StartsOn:  models.Date(row.StartsOn),
+		EndsOn:    models.Date(row.EndsOn),
+		CreatedBy: row.CreatedBy,
+		UpdatedBy: row.UpdatedBy,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+		DeletedAt: deletedAt(row.DeletedAt),
+	}
+}
@@ -0,0 +1,42 @@
+package db
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolStats summarizes a connection pool's utilization, for diagnosing
+// connection exhaustion under load.
+type PoolStats struct {
+	AcquiredConns   int32         `json:"acquired_conns"`
+	IdleConns       int32         `json:"idle_conns"`
+	MaxConns        int32         `json:"max_conns"`
+	TotalConns      int32         `json:"total_conns"`
+	AcquireDuration time.Duration `json:"acquire_duration_ns"`
+}
+
+// Stats returns the primary pool's current statistics.
+func (d *DB) Stats() PoolStats {
+	return statsFromPool(d.pool)
+}
+
+// ReplicaStats returns the read replica pool's current statistics, or the
+// zero value if no replica is configured.
+func (d *DB) ReplicaStats() PoolStats {
+	if d.readPool == nil {
+		return PoolStats{}
+	}
+	return statsFromPool(d.readPool)
+}
+
+func statsFromPool(pool *pgxpool.Pool) PoolStats {
+	s := pool.Stat()
+	return PoolStats{
+		AcquiredConns:   s.AcquiredConns(),
+		IdleConns:       s.IdleConns(),
+		MaxConns:        s.MaxConns(),
+		TotalConns:      s.TotalConns(),
+		AcquireDuration: s.AcquireDuration(),
+	}
+}
@@ -0,0 +1,176 @@
+package db
+
+import (
+	"context"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// EventStore is the persistence contract event handlers depend on, rather
+// than the concrete *DB, so they can be unit-tested with a fake.
+type EventStore interface {
+	GetEvent(ctx context.Context, id int64) (*models.Event, error)
+	GetEventBySlug(ctx context.Context, slug string) (*models.Event, error)
+	ResolveEventSlugRedirect(ctx context.Context, oldSlug string) (*models.Event, error)
+	ListEvents(ctx context.Context, filter EventFilter) ([]models.Event, int, error)
+	EventStats(ctx context.Context) (*EventStats, error)
+	CreateEvent(ctx context.Context, req models.EventRequest) (*models.Event, error)
+	UpdateEvent(ctx context.Context, id int64, req models.EventRequest) (*models.Event, error)
+	DeleteEvent(ctx context.Context, id int64) error
+	ListTrashedEvents(ctx context.Context) ([]models.Event, error)
+	RestoreEvent(ctx context.Context, id int64) (*models.Event, error)
+	RollForwardEvent(ctx context.Context, id int64, years int) (*models.Event, error)
+	DuplicateEvent(ctx context.Context, id int64, req models.EventDuplicateRequest) (*models.Event, error)
+	ListEventHistory(ctx context.Context, eventID int64) ([]models.EventAuditEntry, error)
+	ListEventRevisions(ctx context.Context, eventID int64) ([]models.EventRevision, error)
+	RollbackEventRevision(ctx context.Context, eventID int64, revision int) (*models.Event, error)
+	TransitionEventStatus(ctx context.Context, id int64, next models.EventStatus, reason string) (*models.Event, error)
+	AddEventMedia(ctx context.Context, eventID int64, kind, url, label string) (*models.EventMedia, error)
+	GetEventDebrief(ctx context.Context, eventID int64) (*models.EventDebrief, error)
+	UpsertEventDebrief(ctx context.Context, eventID int64, req models.EventDebriefRequest) (*models.EventDebrief, error)
+	GetCalendarHoldByEvent(ctx context.Context, eventID int64) (*models.CalendarHold, error)
+}
+
+// ProgrammeStore is the persistence contract programme handlers depend on.
+type ProgrammeStore interface {
+	GetProgramme(ctx context.Context, id int64) (*models.Programme, error)
+	ListProgrammes(ctx context.Context) ([]models.Programme, error)
+	CreateProgramme(ctx context.Context, title string) (*models.Programme, error)
+	UpdateProgramme(ctx context.Context, id int64, title string) (*models.Programme, error)
+	DeleteProgramme(ctx context.Context, id int64) error
+	ListTrashedProgrammes(ctx context.Context) ([]models.Programme, error)
+	RestoreProgramme(ctx context.Context, id int64) (*models.Programme, error)
+	SetProgrammePieces(ctx context.Context, programmeID int64, reqs []models.ProgrammePieceRequest) ([]models.ProgrammePiece, error)
+}
+
+// PieceStore is the persistence contract piece handlers depend on.
+type PieceStore interface {
+	GetPiece(ctx context.Context, id int64) (*models.Piece, error)
+	ListPieces(ctx context.Context) ([]models.Piece, error)
+	CreatePiece(ctx context.Context, req models.PieceRequest) (*models.Piece, error)
+	DeletePiece(ctx context.Context, id int64) error
+	ListTrashedPieces(ctx context.Context) ([]models.Piece, error)
+	RestorePiece(ctx context.Context, id int64) (*models.Piece, error)
+}
+
+// ComposerStore is the persistence contract composer handlers depend on.
+type ComposerStore interface {
+	GetComposer(ctx context.Context, id int64) (*models.Composer, error)
+	ListComposers(ctx context.Context) ([]models.Composer, error)
+	CreateComposer(ctx context.Context, name string) (*models.Composer, error)
+	DeleteComposer(ctx context.Context, id int64) error
+	ListTrashedComposers(ctx context.Context) ([]models.Composer, error)
+	RestoreComposer(ctx context.Context, id int64) (*models.Composer, error)
+}
+
+// VenueStore is the persistence contract venue handlers depend on.
+type VenueStore interface {
+	GetVenue(ctx context.Context, id int64) (*models.Venue, error)
+	ListVenues(ctx context.Context) ([]models.Venue, error)
+	CreateVenue(ctx context.Context, name, city, country, description, timezone string) (*models.Venue, error)
+	UpdateVenue(ctx context.Context, id int64, name, city, country, description, timezone string) (*models.Venue, error)
+	DeleteVenue(ctx context.Context, id int64) error
+	ListTrashedVenues(ctx context.Context) ([]models.Venue, error)
+	RestoreVenue(ctx context.Context, id int64) (*models.Venue, error)
+	AddVenueMedia(ctx context.Context, venueID int64, url, label string) (*models.VenueMedia, error)
+	DeleteVenueMedia(ctx context.Context, venueID, mediaID int64) error
+}
+
+// TourStore is the persistence contract tour handlers depend on.
+type TourStore interface {
+	GetTour(ctx context.Context, id int64) (*models.Tour, error)
+	ListTours(ctx context.Context) ([]models.Tour, error)
+	CreateTour(ctx context.Context, req models.TourRequest) (*models.Tour, error)
+	UpdateTour(ctx context.Context, id int64, req models.TourRequest) (*models.Tour, error)
+	DeleteTour(ctx context.Context, id int64) error
+	ListTrashedTours(ctx context.Context) ([]models.Tour, error)
+	RestoreTour(ctx context.Context, id int64) (*models.Tour, error)
+	ListEventsByTour(ctx context.Context, tourID int64) ([]models.Event, error)
+}
+
+// SeasonStore is the persistence contract season handlers depend on.
+type SeasonStore interface {
+	GetSeason(ctx context.Context, id int64) (*models.Season, error)
+	ListSeasons(ctx context.Context) ([]models.Season, error)
+	CreateSeason(ctx context.Context, req models.SeasonRequest) (*models.Season, error)
+	UpdateSeason(ctx context.Context, id int64, req models.SeasonRequest) (*models.Season, error)
+	DeleteSeason(ctx context.Context, id int64) error
+	ListTrashedSeasons(ctx context.Context) ([]models.Season, error)
+	RestoreSeason(ctx context.Context, id int64) (*models.Season, error)
+	ListEventsBySeason(ctx context.Context, seasonID int64) ([]models.Event, error)
+}
+
+// JobStore is the persistence contract the dead-letter queue admin
+// endpoints depend on.
+type JobStore interface {
+	ListDeadJobs(ctx context.Context) ([]Job, error)
+	RetryJob(ctx context.Context, id int64) error
+	RetryDeadJobs(ctx context.Context) (int, error)
+	DeleteJob(ctx context.Context, id int64) error
+}
+
+// RecordingStore is the persistence contract recording handlers depend on.
+type RecordingStore interface {
+	GetRecording(ctx context.Context, id int64) (*models.Recording, error)
+	ListRecordings(ctx context.Context) ([]models.Recording, error)
+	CreateRecording(ctx context.Context, title string, pieceID int64, releaseDate *models.DateTime) (*models.Recording, error)
+	DeleteRecording(ctx context.Context, id int64) error
+	ListTrashedRecordings(ctx context.Context) ([]models.Recording, error)
+	RestoreRecording(ctx context.Context, id int64) (*models.Recording, error)
+	AddRecordingStreamingLink(ctx context.Context, recordingID int64, provider, url string) (*models.RecordingStreamingLink, error)
+	DeleteRecordingStreamingLink(ctx context.Context, recordingID, linkID int64) error
+}
+
+// WebhookChannelStore is the persistence contract webhook channel handlers
+// depend on.
+type WebhookChannelStore interface {
+	GetWebhookChannel(ctx context.Context, id int64) (*models.WebhookChannel, error)
+	ListWebhookChannels(ctx context.Context) ([]models.WebhookChannel, error)
+	CreateWebhookChannel(ctx context.Context, name, url, payloadMode, textTemplate, imageURLTemplate, linkTemplate string) (*models.WebhookChannel, error)
+	UpdateWebhookChannel(ctx context.Context, id int64, name, url, payloadMode, textTemplate, imageURLTemplate, linkTemplate string) (*models.WebhookChannel, error)
+	DeleteWebhookChannel(ctx context.Context, id int64) error
+	ListTrashedWebhookChannels(ctx context.Context) ([]models.WebhookChannel, error)
+	RestoreWebhookChannel(ctx context.Context, id int64) (*models.WebhookChannel, error)
+}
+
+// SubscriberStore is the persistence contract newsletter signup handlers
+// depend on.
+type SubscriberStore interface {
+	CreateSubscriber(ctx context.Context, email string) (*models.Subscriber, error)
+	ListSubscribers(ctx context.Context) ([]models.Subscriber, error)
+}
+
+// AdminUserStore is the persistence contract cmd/ntumiwactl's user
+// subcommands depend on.
+type AdminUserStore interface {
+	CreateAdminUser(ctx context.Context, email, password string) (*models.AdminUser, error)
+	GetAdminUserByEmail(ctx context.Context, email string) (*models.AdminUser, error)
+	SetAdminUserPassword(ctx context.Context, email, password string) (*models.AdminUser, error)
+	SetAdminUserActive(ctx context.Context, email string, active bool) (*models.AdminUser, error)
+}
+
+// ProfileStore is the persistence contract cmd/ntumiwactl's profile
+// subcommands, and event scoping, depend on.
+type ProfileStore interface {
+	GetProfile(ctx context.Context, id int64) (*models.Profile, error)
+	GetProfileBySlug(ctx context.Context, slug string) (*models.Profile, error)
+	ListProfiles(ctx context.Context) ([]models.Profile, error)
+	CreateProfile(ctx context.Context, slug, name string) (*models.Profile, error)
+	ListEventsByProfile(ctx context.Context, profileID int64) ([]models.Event, error)
+}
+
+var (
+	_ EventStore          = (*DB)(nil)
+	_ ProgrammeStore      = (*DB)(nil)
+	_ PieceStore          = (*DB)(nil)
+	_ ComposerStore       = (*DB)(nil)
+	_ VenueStore          = (*DB)(nil)
+	_ TourStore           = (*DB)(nil)
+	_ SeasonStore         = (*DB)(nil)
+	_ JobStore            = (*DB)(nil)
+	_ RecordingStore      = (*DB)(nil)
+	_ WebhookChannelStore = (*DB)(nil)
+	_ SubscriberStore     = (*DB)(nil)
+	_ AdminUserStore      = (*DB)(nil)
+	_ ProfileStore        = (*DB)(nil)
+)
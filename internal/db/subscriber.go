@@ -0,0 +1,92 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// subscriberFromRow converts a generated sqlc row into the
+// models.Subscriber the API layer deals in.
+func subscriberFromRow(row sqlc.Subscriber) *models.Subscriber {
+	return &models.Subscriber{
+		ID:        row.ID,
+		Email:     row.Email,
+		CreatedAt: models.DateTime(row.CreatedAt),
+	}
+}
+
+// CreateSubscriber adds email to the newsletter subscriber list. Signing up
+// twice with the same address is idempotent: it returns the existing row
+// rather than erroring, since the caller is a public sign-up form that
+// can't distinguish "already subscribed" from "just subscribed" and
+// shouldn't need to.
+func (d *DB) CreateSubscriber(ctx context.Context, email string) (*models.Subscriber, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateSubscriber(ctx, email)
+	if err != nil {
+		return nil, fmt.Errorf("db: create subscriber: %w", err)
+	}
+	subscriber := subscriberFromRow(row)
+	d.enqueueNewsletterSubscriberSync(ctx, subscriber.ID)
+	return subscriber, nil
+}
+
+// GetSubscriber returns the subscriber with the given id, for the
+// newsletter.Syncer job to re-read the current state before pushing it to
+// the provider.
+func (d *DB) GetSubscriber(ctx context.Context, id int64) (*models.Subscriber, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetSubscriber(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get subscriber %d: %w", id, err)
+	}
+	return subscriberFromRow(row), nil
+}
+
+// ListSubscribers returns every newsletter subscriber.
+func (d *DB) ListSubscribers(ctx context.Context) ([]models.Subscriber, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListSubscribers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list subscribers: %w", err)
+	}
+
+	subscribers := make([]models.Subscriber, len(rows))
+	for i, row := range rows {
+		subscribers[i] = *subscriberFromRow(row)
+	}
+	return subscribers, nil
+}
+
+// enqueueNewsletterSubscriberSyncPayload is the outbox payload for a
+// JobKindNewsletterSubscriberSync job.
+type enqueueNewsletterSubscriberSyncPayload struct {
+	SubscriberID int64 `json:"subscriber_id"`
+}
+
+// enqueueNewsletterSubscriberSync queues a best-effort job to push id's
+// address to the configured newsletter provider. It follows the same
+// log-and-swallow pattern as enqueueGoogleCalendarSync: a subscriber still
+// exists locally without ever reaching the provider, so a failure to
+// enqueue shouldn't fail the sign-up.
+func (d *DB) enqueueNewsletterSubscriberSync(ctx context.Context, id int64) {
+	payload, err := json.Marshal(enqueueNewsletterSubscriberSyncPayload{SubscriberID: id})
+	if err != nil {
+		log.Printf("db: marshal newsletter subscriber sync payload for subscriber %d: %v", id, err)
+		return
+	}
+	if _, err := d.EnqueueJob(ctx, JobKindNewsletterSubscriberSync, payload, 0); err != nil {
+		log.Printf("db: enqueue newsletter subscriber sync for subscriber %d: %v", id, err)
+	}
+}
@@ -0,0 +1,126 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// ticketOfferFromRow converts a generated sqlc row into the models.TicketOffer
+// the API layer deals in.
+func ticketOfferFromRow(row sqlc.EventTicketOffer) models.TicketOffer {
+	return models.TicketOffer{
+		ID:         row.ID,
+		Label:      row.Label,
+		URL:        row.URL,
+		PriceCents: row.PriceCents,
+		Currency:   row.Currency,
+		Status:     models.TicketStatus(row.Status),
+		CreatedAt:  models.DateTime(row.CreatedAt),
+		UpdatedAt:  models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachTicketOffers fills in TicketOffers on every event in place with a
+// single batched query, rather than one query per event, since a listing
+// endpoint can return dozens of events at once.
+func attachTicketOffers(ctx context.Context, q *sqlc.Queries, events []models.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(events))
+	byID := make(map[int64]*models.Event, len(events))
+	for i := range events {
+		ids[i] = events[i].ID
+		byID[events[i].ID] = &events[i]
+	}
+
+	rows, err := q.ListTicketOffersByEventIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list ticket offers: %w", err)
+	}
+	for _, row := range rows {
+		event, ok := byID[row.EventID]
+		if !ok {
+			continue
+		}
+		event.TicketOffers = append(event.TicketOffers, ticketOfferFromRow(row))
+	}
+	return nil
+}
+
+// ListPublishedTicketOffers returns every ticket offer belonging to a
+// published, non-deleted event, for the ticket status poller to probe.
+func (d *DB) ListPublishedTicketOffers(ctx context.Context) ([]models.TicketOffer, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListPublishedTicketOffers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list published ticket offers: %w", err)
+	}
+
+	offers := make([]models.TicketOffer, len(rows))
+	for i, row := range rows {
+		offers[i] = ticketOfferFromRow(row)
+	}
+	return offers, nil
+}
+
+// UpdateTicketOfferStatus records the ticket poller's latest observation
+// for the offer with the given id. It doesn't notify on ChannelEvents:
+// this is a background sync, not an edit an operator made.
+func (d *DB) UpdateTicketOfferStatus(ctx context.Context, id int64, status models.TicketStatus) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).UpdateTicketOfferStatus(ctx, id, string(status)); err != nil {
+		return fmt.Errorf("db: update ticket offer status %d: %w", id, err)
+	}
+	return nil
+}
+
+// replaceTicketOffers validates each of reqs individually (validate.Struct
+// doesn't recurse into slices) and replaces the event's entire set of
+// offers with them. It's a full delete-then-insert rather than a diff
+// against the existing rows: EventRequest.TicketOffers is a full-replace
+// field, and events rarely carry more than a handful of offers.
+func replaceTicketOffers(ctx context.Context, tx pgx.Tx, eventID int64, reqs []models.TicketOfferRequest) ([]models.TicketOffer, error) {
+	q := sqlc.New(logged(ctx, tx))
+
+	for i, req := range reqs {
+		if err := validate.Struct(req); err != nil {
+			return nil, fmt.Errorf("ticket offer %d: %w", i, err)
+		}
+	}
+
+	if err := q.DeleteEventTicketOffers(ctx, eventID); err != nil {
+		return nil, fmt.Errorf("delete existing ticket offers: %w", err)
+	}
+
+	offers := make([]models.TicketOffer, len(reqs))
+	for i, req := range reqs {
+		var priceCents *int64
+		if v, ok := req.PriceCents.Get(); ok {
+			priceCents = &v
+		}
+		row, err := q.CreateEventTicketOffer(ctx, sqlc.CreateEventTicketOfferParams{
+			EventID:    eventID,
+			Label:      req.Label,
+			URL:        req.URL,
+			PriceCents: priceCents,
+			Currency:   req.Currency,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create ticket offer: %w", err)
+		}
+		offers[i] = ticketOfferFromRow(row)
+	}
+	return offers, nil
+}
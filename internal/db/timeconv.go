@@ -0,0 +1,29 @@
+package db
+
+import (
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// deletedAt converts a nullable sqlc timestamp column into the
+// models.DateTime pointer the wire types use, so a *FromRow converter
+// doesn't have to repeat the nil check.
+func deletedAt(t *time.Time) *models.DateTime {
+	if t == nil {
+		return nil
+	}
+	dt := models.DateTime(*t)
+	return &dt
+}
+
+// startTime converts a nullable sqlc TIME column into the
+// models.TimeOfDay pointer the wire types use, so a *FromRow converter
+// doesn't have to repeat the nil check.
+func startTime(t *time.Time) *models.TimeOfDay {
+	if t == nil {
+		return nil
+	}
+	st := models.TimeOfDay(*t)
+	return &st
+}
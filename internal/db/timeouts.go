@@ -0,0 +1,23 @@
+package db
+
+import "time"
+
+// Timeouts holds the deadlines applied to each class of query. Reads and
+// writes get their own budgets because writes may wait on a lock; reports
+// get the most headroom since they scan more rows than a single-row lookup.
+type Timeouts struct {
+	Read   time.Duration
+	Write  time.Duration
+	Report time.Duration
+}
+
+// DefaultTimeouts returns the timeout classes used when a caller doesn't
+// configure its own, preserving the read/write/report ratio the fixed
+// queryTimeout multipliers used before timeouts became configurable.
+func DefaultTimeouts() Timeouts {
+	return Timeouts{
+		Read:   3 * time.Second,
+		Write:  6 * time.Second,
+		Report: 9 * time.Second,
+	}
+}
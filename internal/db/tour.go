@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"github.com/adamkadda/ntumiwa/internal/validate"
+)
+
+// GetTour returns the tour with the given id.
+func (d *DB) GetTour(ctx context.Context, id int64) (*models.Tour, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetTour(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get tour %d: %w", id, err)
+	}
+	return tourFromRow(row), nil
+}
+
+// ListTours returns every tour, ordered by start date.
+func (d *DB) ListTours(ctx context.Context) ([]models.Tour, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTours(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list tours: %w", err)
+	}
+
+	tours := make([]models.Tour, len(rows))
+	for i, row := range rows {
+		tours[i] = *tourFromRow(row)
+	}
+	return tours, nil
+}
+
+// CreateTour inserts a new tour from req.
+func (d *DB) CreateTour(ctx context.Context, req models.TourRequest) (*models.Tour, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: create tour: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateTour(ctx, sqlc.CreateTourParams{
+		Name:      req.Name,
+		StartsOn:  req.StartsOn.Time(),
+		EndsOn:    req.EndsOn.Time(),
+		CreatedBy: actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create tour: %w", err)
+	}
+	return tourFromRow(row), nil
+}
+
+// UpdateTour replaces the editable fields of the tour with the given id.
+func (d *DB) UpdateTour(ctx context.Context, id int64, req models.TourRequest) (*models.Tour, error) {
+	if err := validate.Struct(req); err != nil {
+		return nil, fmt.Errorf("db: update tour %d: %w", id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).UpdateTour(ctx, sqlc.UpdateTourParams{
+		ID:        id,
+		Name:      req.Name,
+		StartsOn:  req.StartsOn.Time(),
+		EndsOn:    req.EndsOn.Time(),
+		UpdatedBy: actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update tour %d: %w", id, err)
+	}
+	return tourFromRow(row), nil
+}
+
+// DeleteTour moves the tour with the given id to the trash. Events already
+// assigned to it keep their TourID, and ListEventsByTour still returns
+// them, since it filters on the events' tour_id alone.
+func (d *DB) DeleteTour(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteTour(ctx, id); err != nil {
+		return fmt.Errorf("db: delete tour %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedTours returns every tour currently in the trash.
+func (d *DB) ListTrashedTours(ctx context.Context) ([]models.Tour, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTrashedTours(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed tours: %w", err)
+	}
+
+	tours := make([]models.Tour, len(rows))
+	for i, row := range rows {
+		tours[i] = *tourFromRow(row)
+	}
+	return tours, nil
+}
+
+// RestoreTour takes the tour with the given id out of the trash.
+func (d *DB) RestoreTour(ctx context.Context, id int64) (*models.Tour, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).RestoreTour(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore tour %d: %w", id, err)
+	}
+	return tourFromRow(row), nil
+}
+
+// ListEventsByTour returns every event assigned to the tour with the given
+// id, ordered by date, fully hydrated the same way ListEvents hydrates its
+// results.
+func (d *DB) ListEventsByTour(ctx context.Context, tourID int64) ([]models.Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListEventsByTourID(ctx, &tourID)
+	if err != nil {
+		return nil, fmt.Errorf("db: list events by tour %d: %w", tourID, err)
+	}
+
+	events := make([]models.Event, len(rows))
+	for i, row := range rows {
+		events[i] = *eventFromRow(row)
+	}
+	if err := attachTicketOffers(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by tour %d: %w", tourID, err)
+	}
+	if err := attachEventMedia(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by tour %d: %w", tourID, err)
+	}
+	if err := attachEventCollaborators(ctx, q, events); err != nil {
+		return nil, fmt.Errorf("db: list events by tour %d: %w", tourID, err)
+	}
+	return events, nil
+}
+
+// tourFromRow converts a generated sqlc row into the models.Tour the API
+// layer deals in.
+func tourFromRow(row sqlc.Tour) *models.Tour {
+	return &models.Tour{
+		ID:        row.ID,
+		Name:      row.Name,
+		StartsOn:  models.Date(row.StartsOn),
+		EndsOn:    models.Date(row.EndsOn),
+		CreatedBy: row.CreatedBy,
+		UpdatedBy: row.UpdatedBy,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+		DeletedAt: deletedAt(row.DeletedAt),
+	}
+}
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// WithTx runs fn inside a transaction, committing if fn returns nil and
+// rolling back otherwise (including on panic, which it re-panics after
+// rolling back). It centralizes the begin/rollback/commit boilerplate that
+// was previously copy-pasted into each mutating query method.
+//
+// A transaction that fails with a transient error (serialization failure,
+// deadlock, brief connection loss) is retried from the top with jittered
+// backoff, so a failover doesn't surface as a 500 to the caller.
+func (d *DB) WithTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	return withRetry(ctx, d.retryCfg, func() error {
+		return d.runTx(ctx, fn)
+	})
+}
+
+func (d *DB) runTx(ctx context.Context, fn func(tx pgx.Tx) error) (err error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("db: begin transaction: %w", err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback(ctx)
+			panic(p)
+		}
+		if err != nil {
+			tx.Rollback(ctx)
+			return
+		}
+		err = tx.Commit(ctx)
+	}()
+
+	err = fn(tx)
+	return err
+}
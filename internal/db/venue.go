@@ -0,0 +1,193 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// validateTimezone reports an error naming tz if it's non-empty and
+// isn't a zone the tzdata database recognizes, so a typo doesn't sit
+// silently on a venue until an event's local start time comes out wrong.
+func validateTimezone(tz string) error {
+	if tz == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(tz); err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", tz, err)
+	}
+	return nil
+}
+
+// GetVenue returns the venue with the given id.
+func (d *DB) GetVenue(ctx context.Context, id int64) (*models.Venue, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	row, err := q.GetVenue(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get venue %d: %w", id, err)
+	}
+	venues := []models.Venue{*venueFromRow(row)}
+	if err := attachVenueMedia(ctx, q, venues); err != nil {
+		return nil, fmt.Errorf("db: get venue %d: %w", id, err)
+	}
+	return &venues[0], nil
+}
+
+// ListVenues returns every venue.
+func (d *DB) ListVenues(ctx context.Context) ([]models.Venue, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListVenues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list venues: %w", err)
+	}
+
+	venues := make([]models.Venue, len(rows))
+	for i, row := range rows {
+		venues[i] = *venueFromRow(row)
+	}
+	if err := attachVenueMedia(ctx, q, venues); err != nil {
+		return nil, fmt.Errorf("db: list venues: %w", err)
+	}
+	return venues, nil
+}
+
+// CreateVenue inserts a new venue. timezone, when given, must be a zone
+// time.LoadLocation recognizes (e.g. "America/New_York"); it's how an
+// event at this venue's time-of-day gets shown in local time on the
+// public feed.
+func (d *DB) CreateVenue(ctx context.Context, name, city, country, description, timezone string) (*models.Venue, error) {
+	if len(name) < 1 {
+		return nil, fmt.Errorf("db: create venue: name is required")
+	}
+	if err := validateTimezone(timezone); err != nil {
+		return nil, fmt.Errorf("db: create venue: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	venue, err := sqlc.New(logged(ctx, d.pool)).CreateVenue(ctx, sqlc.CreateVenueParams{
+		Name:        name,
+		City:        city,
+		Country:     country,
+		Description: description,
+		Timezone:    timezone,
+		CreatedBy:   actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create venue: %w", err)
+	}
+	return venueFromRow(venue), nil
+}
+
+// UpdateVenue replaces the editable fields of the venue with the given id.
+func (d *DB) UpdateVenue(ctx context.Context, id int64, name, city, country, description, timezone string) (*models.Venue, error) {
+	if len(name) < 1 {
+		return nil, fmt.Errorf("db: update venue: name is required")
+	}
+	if err := validateTimezone(timezone); err != nil {
+		return nil, fmt.Errorf("db: update venue %d: %w", id, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	q := sqlc.New(logged(ctx, d.pool))
+	row, err := q.UpdateVenue(ctx, sqlc.UpdateVenueParams{
+		ID:          id,
+		Name:        name,
+		City:        city,
+		Country:     country,
+		Description: description,
+		Timezone:    timezone,
+		UpdatedBy:   actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update venue %d: %w", id, err)
+	}
+	venues := []models.Venue{*venueFromRow(row)}
+	if err := attachVenueMedia(ctx, q, venues); err != nil {
+		return nil, fmt.Errorf("db: update venue %d: %w", id, err)
+	}
+	return &venues[0], nil
+}
+
+// DeleteVenue moves the venue with the given id to the trash.
+func (d *DB) DeleteVenue(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteVenue(ctx, id); err != nil {
+		return fmt.Errorf("db: delete venue %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedVenues returns every venue currently in the trash.
+func (d *DB) ListTrashedVenues(ctx context.Context) ([]models.Venue, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.reader()))
+	rows, err := q.ListTrashedVenues(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed venues: %w", err)
+	}
+
+	venues := make([]models.Venue, len(rows))
+	for i, row := range rows {
+		venues[i] = *venueFromRow(row)
+	}
+	if err := attachVenueMedia(ctx, q, venues); err != nil {
+		return nil, fmt.Errorf("db: list trashed venues: %w", err)
+	}
+	return venues, nil
+}
+
+// RestoreVenue takes the venue with the given id out of the trash.
+func (d *DB) RestoreVenue(ctx context.Context, id int64) (*models.Venue, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	q := sqlc.New(logged(ctx, d.pool))
+	row, err := q.RestoreVenue(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore venue %d: %w", id, err)
+	}
+	venues := []models.Venue{*venueFromRow(row)}
+	if err := attachVenueMedia(ctx, q, venues); err != nil {
+		return nil, fmt.Errorf("db: restore venue %d: %w", id, err)
+	}
+	return &venues[0], nil
+}
+
+// venueFromRow converts a generated sqlc row into the models.Venue the API
+// layer deals in. Media is left nil; callers attach it separately with
+// attachVenueMedia since it isn't a column on this row.
+func venueFromRow(row sqlc.Venue) *models.Venue {
+	return &models.Venue{
+		ID:          row.ID,
+		Name:        row.Name,
+		City:        row.City,
+		Country:     row.Country,
+		Description: row.Description,
+		Timezone:    row.Timezone,
+		CreatedBy:   row.CreatedBy,
+		UpdatedBy:   row.UpdatedBy,
+		CreatedAt:   models.DateTime(row.CreatedAt),
+		UpdatedAt:   models.DateTime(row.UpdatedAt),
+		DeletedAt:   deletedAt(row.DeletedAt),
+	}
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// venueMediaFromRow converts a generated sqlc row into the models.VenueMedia
+// the API layer deals in.
+func venueMediaFromRow(row sqlc.VenueMedia) models.VenueMedia {
+	return models.VenueMedia{
+		ID:        row.ID,
+		URL:       row.URL,
+		Label:     row.Label,
+		CreatedAt: models.DateTime(row.CreatedAt),
+		UpdatedAt: models.DateTime(row.UpdatedAt),
+	}
+}
+
+// attachVenueMedia fills in Media on every venue in place with a single
+// batched query, rather than one query per venue.
+func attachVenueMedia(ctx context.Context, q *sqlc.Queries, venues []models.Venue) error {
+	if len(venues) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(venues))
+	byID := make(map[int64]*models.Venue, len(venues))
+	for i := range venues {
+		ids[i] = venues[i].ID
+		byID[venues[i].ID] = &venues[i]
+	}
+
+	rows, err := q.ListVenueMediaByVenueIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("db: list venue media: %w", err)
+	}
+	for _, row := range rows {
+		venue, ok := byID[row.VenueID]
+		if !ok {
+			continue
+		}
+		venue.Media = append(venue.Media, venueMediaFromRow(row))
+	}
+	return nil
+}
+
+// AddVenueMedia attaches a new photo to the venue with the given id. Unlike
+// EventRequest.Media there's no full-replace request struct for venue
+// photos: a venue's CRUD is a handful of plain arguments rather than an
+// Optional-backed request, so adding and removing a photo are each their
+// own call.
+func (d *DB) AddVenueMedia(ctx context.Context, venueID int64, url, label string) (*models.VenueMedia, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).CreateVenueMedia(ctx, sqlc.CreateVenueMediaParams{
+		VenueID: venueID,
+		URL:     url,
+		Label:   label,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: add venue media: %w", err)
+	}
+	media := venueMediaFromRow(row)
+	return &media, nil
+}
+
+// DeleteVenueMedia removes a single photo from the venue with the given id.
+func (d *DB) DeleteVenueMedia(ctx context.Context, venueID, mediaID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteVenueMedia(ctx, mediaID, venueID); err != nil {
+		return fmt.Errorf("db: delete venue media %d: %w", mediaID, err)
+	}
+	return nil
+}
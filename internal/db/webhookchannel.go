@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/auth"
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// GetWebhookChannel returns the webhook channel with the given id.
+func (d *DB) GetWebhookChannel(ctx context.Context, id int64) (*models.WebhookChannel, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.reader())).GetWebhookChannel(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: get webhook channel %d: %w", id, err)
+	}
+	return webhookChannelFromRow(row), nil
+}
+
+// ListWebhookChannels returns every webhook channel.
+func (d *DB) ListWebhookChannels(ctx context.Context) ([]models.WebhookChannel, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListWebhookChannels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list webhook channels: %w", err)
+	}
+
+	channels := make([]models.WebhookChannel, len(rows))
+	for i, row := range rows {
+		channels[i] = *webhookChannelFromRow(row)
+	}
+	return channels, nil
+}
+
+// normalizeWebhookPayloadMode defaults an empty payloadMode to
+// PayloadModeTemplate, so existing callers that don't know about flat
+// payloads yet keep behaving exactly as before, and rejects anything else
+// that isn't one of the known modes.
+func normalizeWebhookPayloadMode(payloadMode string) (models.WebhookPayloadMode, error) {
+	if payloadMode == "" {
+		return models.PayloadModeTemplate, nil
+	}
+	mode := models.WebhookPayloadMode(payloadMode)
+	if !mode.Valid() {
+		return "", fmt.Errorf("invalid payload_mode %q", payloadMode)
+	}
+	return mode, nil
+}
+
+// CreateWebhookChannel inserts a new webhook channel.
+func (d *DB) CreateWebhookChannel(ctx context.Context, name, url, payloadMode, textTemplate, imageURLTemplate, linkTemplate string) (*models.WebhookChannel, error) {
+	if len(name) < 1 {
+		return nil, fmt.Errorf("db: create webhook channel: name is required")
+	}
+	if len(url) < 1 {
+		return nil, fmt.Errorf("db: create webhook channel: url is required")
+	}
+	mode, err := normalizeWebhookPayloadMode(payloadMode)
+	if err != nil {
+		return nil, fmt.Errorf("db: create webhook channel: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	channel, err := sqlc.New(logged(ctx, d.pool)).CreateWebhookChannel(ctx, sqlc.CreateWebhookChannelParams{
+		Name:             name,
+		Url:              url,
+		PayloadMode:      mode.String(),
+		TextTemplate:     textTemplate,
+		ImageUrlTemplate: imageURLTemplate,
+		LinkTemplate:     linkTemplate,
+		CreatedBy:        actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: create webhook channel: %w", err)
+	}
+	return webhookChannelFromRow(channel), nil
+}
+
+// UpdateWebhookChannel replaces the editable fields of the webhook channel
+// with the given id.
+func (d *DB) UpdateWebhookChannel(ctx context.Context, id int64, name, url, payloadMode, textTemplate, imageURLTemplate, linkTemplate string) (*models.WebhookChannel, error) {
+	if len(name) < 1 {
+		return nil, fmt.Errorf("db: update webhook channel: name is required")
+	}
+	if len(url) < 1 {
+		return nil, fmt.Errorf("db: update webhook channel: url is required")
+	}
+	mode, err := normalizeWebhookPayloadMode(payloadMode)
+	if err != nil {
+		return nil, fmt.Errorf("db: update webhook channel: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	actor := auth.Actor(ctx)
+	row, err := sqlc.New(logged(ctx, d.pool)).UpdateWebhookChannel(ctx, sqlc.UpdateWebhookChannelParams{
+		ID:               id,
+		Name:             name,
+		Url:              url,
+		PayloadMode:      mode.String(),
+		TextTemplate:     textTemplate,
+		ImageUrlTemplate: imageURLTemplate,
+		LinkTemplate:     linkTemplate,
+		UpdatedBy:        actor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("db: update webhook channel %d: %w", id, err)
+	}
+	return webhookChannelFromRow(row), nil
+}
+
+// DeleteWebhookChannel moves the webhook channel with the given id to the trash.
+func (d *DB) DeleteWebhookChannel(ctx context.Context, id int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).DeleteWebhookChannel(ctx, id); err != nil {
+		return fmt.Errorf("db: delete webhook channel %d: %w", id, err)
+	}
+	return nil
+}
+
+// ListTrashedWebhookChannels returns every webhook channel currently in the trash.
+func (d *DB) ListTrashedWebhookChannels(ctx context.Context) ([]models.WebhookChannel, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	rows, err := sqlc.New(logged(ctx, d.reader())).ListTrashedWebhookChannels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db: list trashed webhook channels: %w", err)
+	}
+
+	channels := make([]models.WebhookChannel, len(rows))
+	for i, row := range rows {
+		channels[i] = *webhookChannelFromRow(row)
+	}
+	return channels, nil
+}
+
+// RestoreWebhookChannel takes the webhook channel with the given id out of the trash.
+func (d *DB) RestoreWebhookChannel(ctx context.Context, id int64) (*models.WebhookChannel, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	row, err := sqlc.New(logged(ctx, d.pool)).RestoreWebhookChannel(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("db: restore webhook channel %d: %w", id, err)
+	}
+	return webhookChannelFromRow(row), nil
+}
+
+// webhookChannelFromRow converts a generated sqlc row into the
+// models.WebhookChannel the API layer deals in.
+func webhookChannelFromRow(row sqlc.WebhookChannel) *models.WebhookChannel {
+	return &models.WebhookChannel{
+		ID:               row.ID,
+		Name:             row.Name,
+		URL:              row.Url,
+		PayloadMode:      models.WebhookPayloadMode(row.PayloadMode),
+		TextTemplate:     row.TextTemplate,
+		ImageURLTemplate: row.ImageUrlTemplate,
+		LinkTemplate:     row.LinkTemplate,
+		CreatedBy:        row.CreatedBy,
+		UpdatedBy:        row.UpdatedBy,
+		CreatedAt:        models.DateTime(row.CreatedAt),
+		UpdatedAt:        models.DateTime(row.UpdatedAt),
+		DeletedAt:        deletedAt(row.DeletedAt),
+	}
+}
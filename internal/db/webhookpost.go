@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db/sqlc"
+)
+
+// HasWebhookPost reports whether a social post has already been sent for the
+// given event on the given channel, so a webhooks.Poster doesn't re-post on
+// every subsequent update to an already-published event.
+func (d *DB) HasWebhookPost(ctx context.Context, eventID, channelID int64) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Read)
+	defer cancel()
+
+	posted, err := sqlc.New(logged(ctx, d.reader())).HasWebhookPost(ctx, sqlc.HasWebhookPostParams{
+		EventID:   eventID,
+		ChannelID: channelID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("db: has webhook post for event %d channel %d: %w", eventID, channelID, err)
+	}
+	return posted, nil
+}
+
+// RecordWebhookPost marks the given event as having been posted to the given
+// channel, so it isn't posted to again.
+func (d *DB) RecordWebhookPost(ctx context.Context, eventID, channelID int64) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeouts.Write)
+	defer cancel()
+
+	if err := sqlc.New(logged(ctx, d.pool)).RecordWebhookPost(ctx, sqlc.RecordWebhookPostParams{
+		EventID:   eventID,
+		ChannelID: channelID,
+	}); err != nil {
+		return fmt.Errorf("db: record webhook post for event %d channel %d: %w", eventID, channelID, err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package errreport abstracts reporting unhandled errors to an external
+// tracker, so a production panic or a handler's 500 isn't only visible in
+// stdout logs. It ships a Sentry implementation and a no-op default.
+package errreport
+
+import "context"
+
+// Reporter reports err to whatever tracker it's configured for. Report
+// must not block its caller for long or panic itself: a broken tracker
+// shouldn't take the request path down with it.
+type Reporter interface {
+	Report(ctx context.Context, err error)
+}
+
+// Kind selects a Reporter implementation via config.
+type Kind string
+
+const (
+	KindNone   Kind = "none"
+	KindSentry Kind = "sentry"
+)
+
+// Config configures whichever Reporter Kind selects.
+type Config struct {
+	Kind        Kind
+	DSN         string
+	Environment string
+}
+
+// New builds the Reporter selected by cfg.Kind.
+func New(cfg Config) (Reporter, error) {
+	switch cfg.Kind {
+	case "", KindNone:
+		return NoopReporter{}, nil
+	case KindSentry:
+		return NewSentryReporter(cfg.DSN, cfg.Environment)
+	default:
+		return nil, &UnknownKindError{Kind: cfg.Kind}
+	}
+}
+
+// UnknownKindError is returned by New for an unrecognised Kind.
+type UnknownKindError struct {
+	Kind Kind
+}
+
+func (e *UnknownKindError) Error() string {
+	return "errreport: unknown reporter kind " + string(e.Kind)
+}
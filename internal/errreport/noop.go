@@ -0,0 +1,9 @@
+package errreport
+
+import "context"
+
+// NoopReporter discards every report. It's the default so dev/test
+// environments don't need a tracker DSN configured.
+type NoopReporter struct{}
+
+func (NoopReporter) Report(context.Context, error) {}
@@ -0,0 +1,94 @@
+package errreport
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SentryReporter posts errors to a Sentry-compatible ingest server using
+// the envelope API directly, so this doesn't need to pull in the official
+// SDK for what's otherwise a couple of HTTP requests.
+type SentryReporter struct {
+	Endpoint    string // https://<host>/api/<project>/envelope/
+	PublicKey   string
+	Environment string
+	Client      *http.Client
+}
+
+// NewSentryReporter parses dsn, the "https://<public_key>@<host>/<project>"
+// string a Sentry project issues, into the envelope endpoint it posts to.
+func NewSentryReporter(dsn, environment string) (*SentryReporter, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("errreport: parse sentry dsn: %w", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("errreport: sentry dsn missing public key")
+	}
+	project := strings.Trim(u.Path, "/")
+	if project == "" {
+		return nil, fmt.Errorf("errreport: sentry dsn missing project id")
+	}
+
+	return &SentryReporter{
+		Endpoint:    fmt.Sprintf("%s://%s/api/%s/envelope/", u.Scheme, u.Host, project),
+		PublicKey:   u.User.Username(),
+		Environment: environment,
+		Client:      http.DefaultClient,
+	}, nil
+}
+
+// Report sends err to Sentry as a single-event envelope. A delivery
+// failure is swallowed rather than returned: reporting an error is best
+// effort, not something worth failing the request over.
+func (s *SentryReporter) Report(ctx context.Context, err error) {
+	event := map[string]any{
+		"event_id":    eventID(),
+		"timestamp":   time.Now().UTC().Format(time.RFC3339),
+		"platform":    "go",
+		"environment": s.Environment,
+		"exception": map[string]any{
+			"values": []map[string]any{
+				{"type": "error", "value": err.Error()},
+			},
+		},
+	}
+	body, marshalErr := json.Marshal(event)
+	if marshalErr != nil {
+		return
+	}
+	header, _ := json.Marshal(map[string]any{"event_id": event["event_id"]})
+	item, _ := json.Marshal(map[string]any{"type": "event", "length": len(body)})
+	envelope := bytes.Join([][]byte{header, item, body}, []byte("\n"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Endpoint, bytes.NewReader(envelope))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf(
+		"Sentry sentry_version=7, sentry_client=ntumiwa/1.0, sentry_key=%s", s.PublicKey))
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return
+	}
+	resp.Body.Close()
+}
+
+// eventID returns a random 32-character hex id, the form Sentry expects.
+// rand.Read only fails if the OS entropy source is broken, in which case
+// the zeroed buffer it leaves behind still yields a validly-shaped id.
+func eventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
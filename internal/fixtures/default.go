@@ -0,0 +1,12 @@
+package fixtures
+
+import _ "embed"
+
+//go:embed default.yaml
+var defaultSet []byte
+
+// Default returns the sample fixture set used for local development and
+// demos: a couple of composers, pieces, a programme and one event.
+func Default() (*Set, error) {
+	return Parse(defaultSet)
+}
@@ -0,0 +1,135 @@
+// Package fixtures loads named sets of sample data — composers, pieces,
+// programmes and events — from YAML or JSON, and creates them through the
+// same repository interfaces production code uses, so a fixture can never
+// describe something the models wouldn't actually accept. The seed flag,
+// a future demo mode and any test that wants realistic data can all load
+// the same fixture files.
+package fixtures
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+	"gopkg.in/yaml.v3"
+)
+
+// Set is a fixture file's contents. Items are created in field order —
+// composers before pieces, programmes before events — since pieces and
+// events refer back to earlier items by name rather than by ID.
+type Set struct {
+	Composers  []Composer  `yaml:"composers" json:"composers"`
+	Pieces     []Piece     `yaml:"pieces" json:"pieces"`
+	Programmes []Programme `yaml:"programmes" json:"programmes"`
+	Events     []Event     `yaml:"events" json:"events"`
+}
+
+// Composer is a fixture composer.
+type Composer struct {
+	Name string `yaml:"name" json:"name"`
+}
+
+// Piece is a fixture piece. Composer must match the Name of a composer
+// listed earlier in the same Set.
+type Piece struct {
+	Title    string `yaml:"title" json:"title"`
+	Composer string `yaml:"composer" json:"composer"`
+}
+
+// Programme is a fixture programme.
+type Programme struct {
+	Title string `yaml:"title" json:"title"`
+}
+
+// Event is a fixture event. EventDate is "2006-01-02". Programme is
+// optional and, if given, must match the Title of a programme listed
+// earlier in the same Set.
+type Event struct {
+	Title     string `yaml:"title" json:"title"`
+	EventDate string `yaml:"event_date" json:"event_date"`
+	Programme string `yaml:"programme,omitempty" json:"programme,omitempty"`
+	Notes     string `yaml:"notes,omitempty" json:"notes,omitempty"`
+}
+
+// Parse decodes a fixture Set from YAML or JSON. JSON is valid YAML, so
+// one decoder handles both without sniffing the input first.
+func Parse(data []byte) (*Set, error) {
+	var set Set
+	if err := yaml.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("fixtures: parse: %w", err)
+	}
+	return &set, nil
+}
+
+// Stores bundles the repository interfaces Apply needs, so a fixture set
+// can be loaded against the real *db.DB or, in a test, a fake that only
+// implements the stores the test cares about.
+type Stores struct {
+	Composers  db.ComposerStore
+	Pieces     db.PieceStore
+	Programmes db.ProgrammeStore
+	Events     db.EventStore
+}
+
+// Apply creates every item in set through stores. It fails on the first
+// error, leaving whatever was already created in place — fixtures are
+// meant to be loaded into a fresh database, not reconciled against one.
+func Apply(ctx context.Context, stores Stores, set *Set) error {
+	composerIDs := make(map[string]int64, len(set.Composers))
+	for _, c := range set.Composers {
+		composer, err := stores.Composers.CreateComposer(ctx, c.Name)
+		if err != nil {
+			return fmt.Errorf("fixtures: composer %q: %w", c.Name, err)
+		}
+		composerIDs[c.Name] = composer.ID
+	}
+
+	for _, p := range set.Pieces {
+		composerID, ok := composerIDs[p.Composer]
+		if !ok {
+			return fmt.Errorf("fixtures: piece %q: unknown composer %q", p.Title, p.Composer)
+		}
+		req := models.PieceRequest{
+			Title:      p.Title,
+			ComposerID: models.Optional[int64]{Set: true, Value: composerID},
+		}
+		if _, err := stores.Pieces.CreatePiece(ctx, req); err != nil {
+			return fmt.Errorf("fixtures: piece %q: %w", p.Title, err)
+		}
+	}
+
+	programmeIDs := make(map[string]int64, len(set.Programmes))
+	for _, p := range set.Programmes {
+		programme, err := stores.Programmes.CreateProgramme(ctx, p.Title)
+		if err != nil {
+			return fmt.Errorf("fixtures: programme %q: %w", p.Title, err)
+		}
+		programmeIDs[p.Title] = programme.ID
+	}
+
+	for _, e := range set.Events {
+		eventDate, err := time.Parse("2006-01-02", e.EventDate)
+		if err != nil {
+			return fmt.Errorf("fixtures: event %q: invalid event_date %q: %w", e.Title, e.EventDate, err)
+		}
+		req := models.EventRequest{
+			Title:     e.Title,
+			EventDate: models.Date(eventDate),
+			Notes:     models.Optional[string]{Set: e.Notes != "", Value: e.Notes},
+		}
+		if e.Programme != "" {
+			programmeID, ok := programmeIDs[e.Programme]
+			if !ok {
+				return fmt.Errorf("fixtures: event %q: unknown programme %q", e.Title, e.Programme)
+			}
+			req.ProgrammeID = models.Optional[int64]{Set: true, Value: programmeID}
+		}
+		if _, err := stores.Events.CreateEvent(ctx, req); err != nil {
+			return fmt.Errorf("fixtures: event %q: %w", e.Title, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package googlecalendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// calendarAPIBase is the Google Calendar v3 REST endpoint. APIClient talks
+// to it directly rather than through Google's Go client library, to avoid
+// pulling its dependency tree in for three simple calls.
+const calendarAPIBase = "https://www.googleapis.com/calendar/v3"
+
+// APIClient is a Client backed by the real Google Calendar v3 API. It
+// expects Token to already be a valid OAuth2 access token; refreshing an
+// expired one is out of scope here and is left to whatever supplies Token.
+type APIClient struct {
+	Token  string
+	Client *http.Client
+}
+
+// dateOnlyEvent is the request/response body shape for an all-day event:
+// Start/End use "date" fields, not "dateTime", matching how Google
+// represents a block with no time-of-day. End is exclusive, so a one-day
+// hold's end date is start + 1 day.
+type dateOnlyEvent struct {
+	ID      string      `json:"id,omitempty"`
+	Summary string      `json:"summary"`
+	Start   dateOnlyPtr `json:"start"`
+	End     dateOnlyPtr `json:"end"`
+}
+
+type dateOnlyPtr struct {
+	Date string `json:"date"`
+}
+
+const dateOnlyLayout = "2006-01-02"
+
+// CreateEvent inserts a new all-day event on calendarID and returns the
+// Google-assigned event id.
+func (c *APIClient) CreateEvent(ctx context.Context, calendarID string, ev CalendarEvent) (string, error) {
+	url := fmt.Sprintf("%s/calendars/%s/events", calendarAPIBase, calendarID)
+	var out dateOnlyEvent
+	if err := c.do(ctx, http.MethodPost, url, toDateOnlyEvent(ev), &out); err != nil {
+		return "", fmt.Errorf("googlecalendar: create event: %w", err)
+	}
+	return out.ID, nil
+}
+
+// UpdateEvent overwrites the event googleEventID on calendarID with ev.
+func (c *APIClient) UpdateEvent(ctx context.Context, calendarID, googleEventID string, ev CalendarEvent) error {
+	url := fmt.Sprintf("%s/calendars/%s/events/%s", calendarAPIBase, calendarID, googleEventID)
+	if err := c.do(ctx, http.MethodPut, url, toDateOnlyEvent(ev), nil); err != nil {
+		return fmt.Errorf("googlecalendar: update event %s: %w", googleEventID, err)
+	}
+	return nil
+}
+
+// DeleteEvent removes the event googleEventID from calendarID. A 404 or 410
+// (already gone) is treated as success, since the desired end state -
+// "this event isn't on the calendar" - already holds.
+func (c *APIClient) DeleteEvent(ctx context.Context, calendarID, googleEventID string) error {
+	url := fmt.Sprintf("%s/calendars/%s/events/%s", calendarAPIBase, calendarID, googleEventID)
+	if err := c.do(ctx, http.MethodDelete, url, nil, nil); err != nil {
+		if apiErr, ok := err.(*apiError); ok && (apiErr.StatusCode == http.StatusNotFound || apiErr.StatusCode == http.StatusGone) {
+			return nil
+		}
+		return fmt.Errorf("googlecalendar: delete event %s: %w", googleEventID, err)
+	}
+	return nil
+}
+
+// apiError carries the HTTP status of a failed request, so callers can
+// branch on it (DeleteEvent's not-found/gone tolerance) without parsing the
+// error string.
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+func (c *APIClient) do(ctx context.Context, method, url string, body interface{}, out interface{}) error {
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("marshal request body: %w", err)
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &apiError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("parse response: %w", err)
+		}
+	}
+	return nil
+}
+
+func toDateOnlyEvent(ev CalendarEvent) dateOnlyEvent {
+	start := ev.Start
+	end := start.AddDate(0, 0, 1)
+	return dateOnlyEvent{
+		Summary: ev.Summary,
+		Start:   dateOnlyPtr{Date: start.Format(dateOnlyLayout)},
+		End:     dateOnlyPtr{Date: end.Format(dateOnlyLayout)},
+	}
+}
+
+var _ Client = (*APIClient)(nil)
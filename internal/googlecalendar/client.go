@@ -0,0 +1,23 @@
+package googlecalendar
+
+import (
+	"context"
+	"time"
+)
+
+// CalendarEvent is the subset of a Google Calendar event Syncer needs to
+// keep in sync: an all-day block on Start's date, matching models.Date's
+// date-only semantics. An event's optional start time isn't carried over,
+// so there's no dateTime/timeZone pair to thread through here.
+type CalendarEvent struct {
+	Summary string
+	Start   time.Time
+}
+
+// Client is the Google Calendar API surface Syncer depends on, so it can be
+// faked in place of a real APIClient.
+type Client interface {
+	CreateEvent(ctx context.Context, calendarID string, ev CalendarEvent) (googleEventID string, err error)
+	UpdateEvent(ctx context.Context, calendarID, googleEventID string, ev CalendarEvent) error
+	DeleteEvent(ctx context.Context, calendarID, googleEventID string) error
+}
@@ -0,0 +1,122 @@
+// Package googlecalendar mirrors published events into a Google Calendar,
+// via the outbox: internal/db/event.go enqueues a job on every event write
+// that could affect publication state, and Syncer.Handle reconciles that
+// one event's current state with the calendar rather than acting on
+// whatever the write happened to be.
+package googlecalendar
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Syncer depends on.
+type Store interface {
+	GetEvent(ctx context.Context, id int64) (*models.Event, error)
+	GetGoogleCalendarSync(ctx context.Context, eventID int64) (*models.GoogleCalendarSync, error)
+	UpsertGoogleCalendarSync(ctx context.Context, eventID int64, googleEventID string) (*models.GoogleCalendarSync, error)
+	DeleteGoogleCalendarSync(ctx context.Context, eventID int64) error
+}
+
+// Syncer reconciles one event at a time against the configured Google
+// Calendar: mirrored while published, removed otherwise.
+type Syncer struct {
+	Store      Store
+	Client     Client
+	CalendarID string
+}
+
+// syncPayload is the outbox payload enqueued by internal/db/event.go: just
+// the event id, since Handle re-derives what to do from the event's
+// current state.
+type syncPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// Handle implements jobs.Handler: it decodes payload, re-fetches the
+// event's current state and either upserts or removes its Google Calendar
+// mirror. Re-fetching rather than trusting the payload means it self-heals
+// regardless of which mutation (update, delete, restore) triggered the
+// enqueue, and copes correctly with a job that was delayed long enough for
+// the event to change again in the meantime.
+func (s *Syncer) Handle(ctx context.Context, payload []byte) error {
+	var p syncPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("googlecalendar: unmarshal payload: %w", err)
+	}
+
+	event, err := s.Store.GetEvent(ctx, p.EventID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return s.remove(ctx, p.EventID)
+	case err != nil:
+		return fmt.Errorf("googlecalendar: get event %d: %w", p.EventID, err)
+	}
+
+	if event.Status != models.StatusPublished {
+		return s.remove(ctx, p.EventID)
+	}
+	return s.upsert(ctx, event)
+}
+
+// upsert creates or updates event's mirrored Google Calendar entry,
+// recording the resulting google_event_id.
+func (s *Syncer) upsert(ctx context.Context, event *models.Event) error {
+	ev := CalendarEvent{Summary: event.Title, Start: event.EventDate.Time()}
+
+	sync, err := s.Store.GetGoogleCalendarSync(ctx, event.ID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		googleEventID, err := s.Client.CreateEvent(ctx, s.CalendarID, ev)
+		if err != nil {
+			return fmt.Errorf("googlecalendar: create event %d: %w", event.ID, err)
+		}
+		if _, err := s.Store.UpsertGoogleCalendarSync(ctx, event.ID, googleEventID); err != nil {
+			return fmt.Errorf("googlecalendar: record sync for event %d: %w", event.ID, err)
+		}
+		log.Printf("googlecalendar: mirrored event %d as %s", event.ID, googleEventID)
+		return nil
+	case err != nil:
+		return fmt.Errorf("googlecalendar: get sync for event %d: %w", event.ID, err)
+	}
+
+	if err := s.Client.UpdateEvent(ctx, s.CalendarID, sync.GoogleEventID, ev); err != nil {
+		return fmt.Errorf("googlecalendar: update event %d: %w", event.ID, err)
+	}
+	if _, err := s.Store.UpsertGoogleCalendarSync(ctx, event.ID, sync.GoogleEventID); err != nil {
+		return fmt.Errorf("googlecalendar: record sync for event %d: %w", event.ID, err)
+	}
+	return nil
+}
+
+// remove deletes eventID's mirrored Google Calendar entry, if it has one.
+// It's a no-op if the event was never mirrored, which is the common case
+// for a draft event that's never been published.
+func (s *Syncer) remove(ctx context.Context, eventID int64) error {
+	sync, err := s.Store.GetGoogleCalendarSync(ctx, eventID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("googlecalendar: get sync for event %d: %w", eventID, err)
+	}
+
+	if err := s.Client.DeleteEvent(ctx, s.CalendarID, sync.GoogleEventID); err != nil {
+		return fmt.Errorf("googlecalendar: delete event %d: %w", eventID, err)
+	}
+	if err := s.Store.DeleteGoogleCalendarSync(ctx, eventID); err != nil {
+		return fmt.Errorf("googlecalendar: delete sync for event %d: %w", eventID, err)
+	}
+	log.Printf("googlecalendar: unmirrored event %d", eventID)
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)
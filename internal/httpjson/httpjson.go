@@ -0,0 +1,252 @@
+// Package httpjson reads and writes the JSON HTTP handlers deal in.
+// Responses are encoded using a pool of reusable buffers; Decode hardens
+// request decoding against oversized or malformed bodies. Shared by the
+// api package's handlers and the api binary's admin endpoints, so neither
+// has to hand-roll its own encoding helper.
+package httpjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+var bufPool = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// MaxRequestBodyBytes bounds how much of a request body Decode will read,
+// so an unauthenticated caller can't force a large allocation just by
+// sending an oversized body.
+const MaxRequestBodyBytes = 1 << 20 // 1MB
+
+// maxDecodeDepth bounds how deeply nested a decoded JSON value may be, well
+// above anything a real request body needs, so a deeply nested array or
+// object can't blow the stack via encoding/json's recursive descent.
+const maxDecodeDepth = 32
+
+// maxNumberDigits bounds how many digits a single JSON number literal may
+// have, so a pathological literal can't cost outsized CPU to parse.
+const maxNumberDigits = 32
+
+// Decode reads r.Body as a single JSON value into v. It caps the body at
+// MaxRequestBodyBytes, rejects fields v doesn't declare, rejects a value
+// nested deeper than maxDecodeDepth, rejects a JSON object with a
+// repeated key (encoding/json silently keeps the last one, so a body
+// that disagrees with itself would otherwise decode without complaint),
+// rejects an oversized number literal, and errors if anything follows
+// the value (a second top-level object, say) — so a handler decoding
+// untrusted input doesn't have to reimplement any of that itself.
+func Decode(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	r.Body = http.MaxBytesReader(w, r.Body, MaxRequestBodyBytes)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+
+	if err := validateShape(body); err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("httpjson: body must contain a single JSON value")
+	}
+	return nil
+}
+
+// shapeFrame is one level of nesting validateShape is currently inside:
+// isObject distinguishes an object from an array (only an object can have
+// duplicate keys), expectKey is only meaningful for an object and
+// alternates as its key/value pairs go by, and seen holds the object's
+// keys encountered so far.
+type shapeFrame struct {
+	isObject  bool
+	expectKey bool
+	seen      map[string]bool
+}
+
+// validateShape walks body's JSON tokens without decoding into any target,
+// checking the properties encoding/json won't check on its own: nesting
+// depth, duplicate object keys, and number literal length. It relies on
+// json.Decoder.Token to have already rejected anything syntactically
+// invalid, so it only has to track shape, not grammar.
+func validateShape(body []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var stack []*shapeFrame
+	afterValue := func() {
+		if len(stack) > 0 && stack[len(stack)-1].isObject {
+			stack[len(stack)-1].expectKey = true
+		}
+	}
+
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if delim, ok := tok.(json.Delim); ok {
+			switch delim {
+			case '{':
+				depth++
+				if depth > maxDecodeDepth {
+					return fmt.Errorf("httpjson: body nested too deeply")
+				}
+				stack = append(stack, &shapeFrame{isObject: true, expectKey: true, seen: map[string]bool{}})
+			case '[':
+				depth++
+				if depth > maxDecodeDepth {
+					return fmt.Errorf("httpjson: body nested too deeply")
+				}
+				stack = append(stack, &shapeFrame{})
+			case '}', ']':
+				depth--
+				stack = stack[:len(stack)-1]
+				afterValue()
+			}
+			continue
+		}
+
+		if s, ok := tok.(string); ok && len(stack) > 0 && stack[len(stack)-1].isObject && stack[len(stack)-1].expectKey {
+			top := stack[len(stack)-1]
+			if top.seen[s] {
+				return fmt.Errorf("httpjson: duplicate key %q in body", s)
+			}
+			top.seen[s] = true
+			top.expectKey = false
+			continue
+		}
+
+		if n, ok := tok.(json.Number); ok && len(n.String()) > maxNumberDigits {
+			return fmt.Errorf("httpjson: number literal too long")
+		}
+		afterValue()
+	}
+}
+
+// Respond encodes v as JSON into a pooled buffer and writes it to w in a
+// single call. Encoding into a buffer first, rather than straight into w,
+// means a marshaling error is caught before the status line and headers
+// go out, and the buffer's backing array is reused across requests
+// instead of allocated fresh by a new json.Encoder every time.
+func Respond(w http.ResponseWriter, status int, v interface{}) {
+	buf := bufPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer bufPool.Put(buf)
+
+	if err := json.NewEncoder(buf).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_, _ = w.Write(buf.Bytes())
+}
+
+// Fields parses the ?fields= query parameter into the set of field names
+// it names, or nil if the parameter is absent or empty, meaning "every
+// field". Callers pass the result straight to StreamArray.
+func Fields(r *http.Request) []string {
+	raw := r.URL.Query().Get("fields")
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, ",")
+}
+
+// StreamArray writes items as a JSON array, encoding one element at a time
+// against a pooled buffer instead of marshaling the whole array into
+// memory first. Use it for listing endpoints whose result set can grow
+// large enough that a single full-response allocation matters.
+//
+// If fields is non-empty, each element is cut down to just those top-level
+// fields before being written, so a lightweight picker (a venue dropdown,
+// say) doesn't pay for the full object over the wire.
+func StreamArray[T any](w http.ResponseWriter, status int, items []T, fields []string) error {
+	buf := bufPool.Get().(*bytes.Buffer)
+	defer bufPool.Put(buf)
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+
+	if _, err := w.Write([]byte{'['}); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(buf)
+	for i, item := range items {
+		buf.Reset()
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+		encoded := bytes.TrimRight(buf.Bytes(), "\n")
+		if len(fields) > 0 {
+			if filtered, err := selectFields(encoded, fields); err == nil {
+				encoded = filtered
+			}
+		}
+		if i > 0 {
+			if _, err := w.Write([]byte{','}); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(encoded); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write([]byte{']'})
+	return err
+}
+
+// SelectedFields marshals each item, cutting it down to just the given
+// top-level fields when fields is non-empty, for embedding in a response
+// envelope that Respond writes as a whole rather than streaming — e.g. a
+// paginated list alongside a total count, which StreamArray can't
+// represent since it owns the entire response body.
+func SelectedFields[T any](items []T, fields []string) ([]json.RawMessage, error) {
+	out := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		encoded, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		if len(fields) > 0 {
+			if filtered, err := selectFields(encoded, fields); err == nil {
+				encoded = filtered
+			}
+		}
+		out[i] = encoded
+	}
+	return out, nil
+}
+
+// selectFields cuts a JSON-encoded object down to the given top-level
+// field names. If encoded isn't a JSON object, it's returned unchanged.
+func selectFields(encoded []byte, fields []string) ([]byte, error) {
+	var full map[string]json.RawMessage
+	if err := json.Unmarshal(encoded, &full); err != nil {
+		return encoded, nil
+	}
+	filtered := make(map[string]json.RawMessage, len(fields))
+	for _, f := range fields {
+		if v, ok := full[f]; ok {
+			filtered[f] = v
+		}
+	}
+	return json.Marshal(filtered)
+}
@@ -0,0 +1,37 @@
+package httpjson
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// FuzzDecode exercises Decode against arbitrary bodies. It doesn't assert
+// success or failure of any particular input — only that Decode always
+// returns rather than panicking or hanging, which is exactly what its
+// depth limit and duplicate-key/number-length checks are there to
+// guarantee against a body an attacker controls.
+func FuzzDecode(f *testing.F) {
+	seeds := []string{
+		`{}`,
+		`{"a":1}`,
+		`[1,2,3]`,
+		`{"a":{"b":{"c":1}}}`,
+		`{"a":1,"a":2}`,
+		`123456789012345678901234567890123456789012345678901234567890`,
+		`not json`,
+		``,
+		`[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[[]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]]`,
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, body string) {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		var v interface{}
+		_ = Decode(w, req, &v)
+	})
+}
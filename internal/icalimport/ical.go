@@ -0,0 +1,124 @@
+// Package icalimport pulls VEVENTs off an external .ics calendar — a
+// manager's hold calendar, typically — and mirrors each one into a draft
+// event, so a hold blocking a date shows up internally without anyone
+// re-entering it by hand.
+package icalimport
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// VEvent is the handful of a VEVENT block this importer actually uses.
+// Everything else (attendees, alarms, recurrence rules) is ignored; a
+// hold calendar is expected to be a flat list of one-off blocks, not a
+// recurring schedule.
+type VEvent struct {
+	UID     string
+	Summary string
+	Start   time.Time
+}
+
+// dateTimeLayouts are the DTSTART value formats this parser understands:
+// a floating or UTC date-time, and an all-day DATE value at midnight. A
+// value carrying a TZID parameter is read as if it were UTC, since there's
+// no timezone database wired in here to resolve it properly.
+var dateTimeLayouts = []string{"20060102T150405Z", "20060102T150405", "20060102"}
+
+// ParseVEvents extracts every VEVENT block from raw .ics data. A VEVENT
+// missing a UID or a parseable DTSTART is skipped, since neither can be
+// mapped to a draft event or matched against a previous import.
+func ParseVEvents(raw []byte) []VEvent {
+	var events []VEvent
+	var current *VEvent
+	for _, line := range unfold(raw) {
+		switch {
+		case line == "BEGIN:VEVENT":
+			current = &VEvent{}
+		case line == "END:VEVENT":
+			if current != nil && current.UID != "" && !current.Start.IsZero() {
+				events = append(events, *current)
+			}
+			current = nil
+		case current != nil:
+			name, value, ok := splitProperty(line)
+			if !ok {
+				continue
+			}
+			switch name {
+			case "UID":
+				current.UID = value
+			case "SUMMARY":
+				current.Summary = unescapeText(value)
+			case "DTSTART":
+				if t, err := parseDateTime(value); err == nil {
+					current.Start = t
+				}
+			}
+		}
+	}
+	return events
+}
+
+// unfold rejoins RFC 5545's folded lines: a line beginning with a single
+// space or tab is a continuation of the previous one, not a new property.
+func unfold(raw []byte) []string {
+	scanner := bufio.NewScanner(bytes.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// splitProperty splits a property line into its name, with any
+// parameters (;TZID=..., ;VALUE=DATE) dropped, and its value.
+func splitProperty(line string) (name, value string, ok bool) {
+	idx := strings.IndexByte(line, ':')
+	if idx < 0 {
+		return "", "", false
+	}
+	name = line[:idx]
+	if semi := strings.IndexByte(name, ';'); semi >= 0 {
+		name = name[:semi]
+	}
+	return strings.ToUpper(name), line[idx+1:], true
+}
+
+// parseDateTime tries each of dateTimeLayouts in turn against value.
+func parseDateTime(value string) (time.Time, error) {
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("icalimport: unrecognized DTSTART value %q", value)
+}
+
+// unescapeText reverses the backslash-escaping RFC 5545 requires for TEXT
+// values: commas, semicolons, backslashes and literal newlines.
+func unescapeText(value string) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		if value[i] == '\\' && i+1 < len(value) {
+			i++
+			if value[i] == 'n' || value[i] == 'N' {
+				b.WriteByte('\n')
+			} else {
+				b.WriteByte(value[i])
+			}
+			continue
+		}
+		b.WriteByte(value[i])
+	}
+	return b.String()
+}
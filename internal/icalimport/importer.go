@@ -0,0 +1,150 @@
+package icalimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Importer depends on.
+type Store interface {
+	GetCalendarHold(ctx context.Context, externalUID string) (*models.CalendarHold, error)
+	CreateCalendarHold(ctx context.Context, req models.CalendarHoldRequest) (*models.CalendarHold, error)
+	UpdateCalendarHold(ctx context.Context, externalUID string, req models.CalendarHoldRequest) (*models.CalendarHold, error)
+	CreateEvent(ctx context.Context, req models.EventRequest) (*models.Event, error)
+	UpdateEvent(ctx context.Context, id int64, req models.EventRequest) (*models.Event, error)
+}
+
+// defaultTitle stands in for a VEVENT with no SUMMARY, since Event.Title
+// is required.
+const defaultTitle = "Hold"
+
+// Importer syncs VEVENTs from a single external .ics URL into draft
+// events, keyed by each VEVENT's UID so a re-sync updates rather than
+// duplicates a hold that's already been imported.
+type Importer struct {
+	Store  Store
+	URL    string
+	Client *http.Client
+}
+
+// Sync fetches Importer.URL, parses every VEVENT in it, and creates or
+// updates the draft event and CalendarHold for each one. A single VEVENT
+// failing to import is logged and skipped rather than aborting the rest
+// of the batch, the same way tickets.Refresher treats one offer's probe
+// failing.
+func (im *Importer) Sync(ctx context.Context) error {
+	client := im.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, im.URL, nil)
+	if err != nil {
+		return fmt.Errorf("icalimport: build request for %s: %w", im.URL, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("icalimport: fetch %s: %w", im.URL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("icalimport: %s returned status %d", im.URL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 8<<20))
+	if err != nil {
+		return fmt.Errorf("icalimport: read %s: %w", im.URL, err)
+	}
+
+	var created, updated int
+	for _, ve := range ParseVEvents(body) {
+		did, err := im.syncOne(ctx, ve)
+		if err != nil {
+			log.Printf("icalimport: sync %q: %v", ve.UID, err)
+			continue
+		}
+		switch did {
+		case syncCreated:
+			created++
+		case syncUpdated:
+			updated++
+		}
+	}
+	if created > 0 || updated > 0 {
+		log.Printf("icalimport: synced %s: %d added, %d updated", im.URL, created, updated)
+	}
+	return nil
+}
+
+type syncResult int
+
+const (
+	syncUnchanged syncResult = iota
+	syncCreated
+	syncUpdated
+)
+
+// syncOne creates or refreshes the draft event and CalendarHold for a
+// single VEVENT.
+func (im *Importer) syncOne(ctx context.Context, ve VEvent) (syncResult, error) {
+	title := ve.Summary
+	if title == "" {
+		title = defaultTitle
+	}
+
+	existing, err := im.Store.GetCalendarHold(ctx, ve.UID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		event, err := im.Store.CreateEvent(ctx, models.EventRequest{
+			Title:     title,
+			EventDate: models.Date(ve.Start),
+		})
+		if err != nil {
+			return syncUnchanged, fmt.Errorf("create event: %w", err)
+		}
+		if _, err := im.Store.CreateCalendarHold(ctx, models.CalendarHoldRequest{
+			ExternalUID: ve.UID,
+			SourceURL:   im.URL,
+			EventID:     event.ID,
+			Summary:     ve.Summary,
+			StartsAt:    ve.Start,
+		}); err != nil {
+			return syncUnchanged, fmt.Errorf("record calendar hold: %w", err)
+		}
+		return syncCreated, nil
+	case err != nil:
+		return syncUnchanged, fmt.Errorf("get calendar hold: %w", err)
+	}
+
+	if existing.Summary == ve.Summary && existing.StartsAt.Time().Equal(ve.Start) {
+		return syncUnchanged, nil
+	}
+
+	if _, err := im.Store.UpdateEvent(ctx, existing.EventID, models.EventRequest{
+		Title:     title,
+		EventDate: models.Date(ve.Start),
+	}); err != nil {
+		return syncUnchanged, fmt.Errorf("update event %d: %w", existing.EventID, err)
+	}
+	if _, err := im.Store.UpdateCalendarHold(ctx, ve.UID, models.CalendarHoldRequest{
+		ExternalUID: ve.UID,
+		SourceURL:   im.URL,
+		EventID:     existing.EventID,
+		Summary:     ve.Summary,
+		StartsAt:    ve.Start,
+	}); err != nil {
+		return syncUnchanged, fmt.Errorf("update calendar hold: %w", err)
+	}
+	return syncUpdated, nil
+}
+
+var _ Store = (*db.DB)(nil)
@@ -0,0 +1,108 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+)
+
+// Handler processes one job's payload. Returning an error marks the job
+// failed: eligible for retry with backoff, or dead-lettered once its
+// attempts are exhausted.
+type Handler func(ctx context.Context, payload []byte) error
+
+// PoolStore is the persistence contract Pool depends on.
+type PoolStore interface {
+	ClaimJobs(ctx context.Context, workerID string, limit int) ([]db.Job, error)
+	CompleteJob(ctx context.Context, id int64) error
+	FailJob(ctx context.Context, id int64, jobErr error, delay time.Duration) error
+}
+
+// Pool polls the jobs table and dispatches claimed jobs to the Handler
+// registered for their kind, running up to Concurrency of them at once.
+type Pool struct {
+	Store        PoolStore
+	Handlers     map[string]Handler
+	WorkerID     string
+	Concurrency  int
+	PollInterval time.Duration
+}
+
+var errNoHandler = fmt.Errorf("jobs: no handler registered for this kind")
+
+// Run polls for claimable jobs until ctx is done. It's meant to be started
+// in its own goroutine and left running for the process lifetime.
+func (p *Pool) Run(ctx context.Context) {
+	interval := p.PollInterval
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	concurrency := p.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	sem := make(chan struct{}, concurrency)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			jobs, err := p.Store.ClaimJobs(ctx, p.WorkerID, concurrency)
+			if err != nil {
+				log.Printf("jobs: claim: %v", err)
+				continue
+			}
+			for _, job := range jobs {
+				job := job
+				sem <- struct{}{}
+				go func() {
+					defer func() { <-sem }()
+					p.process(ctx, job)
+				}()
+			}
+		}
+	}
+}
+
+func (p *Pool) process(ctx context.Context, job db.Job) {
+	handler, ok := p.Handlers[job.Kind]
+	if !ok {
+		p.fail(ctx, job, errNoHandler)
+		return
+	}
+
+	if err := handler(ctx, job.Payload); err != nil {
+		p.fail(ctx, job, err)
+		return
+	}
+	if err := p.Store.CompleteJob(ctx, job.ID); err != nil {
+		log.Printf("jobs: complete job %d: %v", job.ID, err)
+	}
+}
+
+func (p *Pool) fail(ctx context.Context, job db.Job, jobErr error) {
+	if err := p.Store.FailJob(ctx, job.ID, jobErr, backoff(job.Attempts)); err != nil {
+		log.Printf("jobs: fail job %d: %v", job.ID, err)
+	}
+}
+
+// backoff returns a jittered exponential delay for the given attempt count
+// (1-indexed), capped at 5 minutes. Job retries can tolerate much longer
+// waits than a database transaction (see internal/db/retry.go's backoff),
+// since a queued job isn't holding anything up.
+func backoff(attempt int) time.Duration {
+	delay := time.Second << uint(attempt-1)
+	if delay > 5*time.Minute || delay <= 0 {
+		delay = 5 * time.Minute
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay)/2 + 1))
+	return delay/2 + jitter
+}
@@ -0,0 +1,42 @@
+// Package jobs implements a database-backed job queue: work is enqueued as a
+// row in the jobs table so it survives process restarts, and a Pool of
+// workers claims and runs it with SELECT ... FOR UPDATE SKIP LOCKED, retrying
+// failures with backoff before giving up and dead-lettering the job.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+)
+
+// EnqueueStore is the persistence contract Queue depends on.
+type EnqueueStore interface {
+	EnqueueJob(ctx context.Context, kind string, payload []byte, maxAttempts int) (*db.Job, error)
+}
+
+// Queue enqueues background work backed by the jobs table.
+type Queue struct {
+	Store EnqueueStore
+}
+
+// Enqueue marshals payload to JSON and inserts a pending job of the given
+// kind. maxAttempts <= 0 falls back to the store's default.
+func (q *Queue) Enqueue(ctx context.Context, kind string, payload interface{}, maxAttempts int) (*db.Job, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("jobs: marshal %s payload: %w", kind, err)
+	}
+	job, err := q.Store.EnqueueJob(ctx, kind, b, maxAttempts)
+	if err != nil {
+		return nil, fmt.Errorf("jobs: enqueue %s: %w", kind, err)
+	}
+	return job, nil
+}
+
+var (
+	_ EnqueueStore = (*db.DB)(nil)
+	_ PoolStore    = (*db.DB)(nil)
+)
@@ -0,0 +1,80 @@
+// Package locale renders dates the way a human reader of a given language
+// expects to see them, for response fields like Performance.TextDate that
+// are meant to be read directly rather than parsed. Go's time.Format has
+// no notion of language, so month names and field order are looked up by
+// hand instead of leaning on it beyond digit formatting.
+package locale
+
+import (
+	"strings"
+	"time"
+)
+
+// Locale identifies a supported display language by its two-letter tag.
+// The zero value is not a valid Locale; use Default or Parse.
+type Locale string
+
+const (
+	English Locale = "en"
+	French  Locale = "fr"
+	German  Locale = "de"
+	Spanish Locale = "es"
+)
+
+// Default is used whenever a request names no locale, or names one this
+// package doesn't recognize.
+const Default = English
+
+var months = map[Locale][12]string{
+	English: {"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+	French:  {"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+	German:  {"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+	Spanish: {"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+}
+
+// Parse maps a raw locale tag (an explicit ?locale= value, or one entry
+// from an Accept-Language header) to a supported Locale, falling back to
+// Default for anything unrecognized. It only looks at the primary
+// language subtag, so "fr-CA" and "fr" both resolve to French.
+func Parse(raw string) Locale {
+	tag := strings.ToLower(strings.TrimSpace(raw))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	switch Locale(tag) {
+	case French, German, Spanish:
+		return Locale(tag)
+	default:
+		return Default
+	}
+}
+
+// FromAcceptLanguage parses the header's first, most-preferred language
+// range and resolves it with Parse. A malformed or empty header resolves
+// to Default the same way an unrecognized language would.
+func FromAcceptLanguage(header string) Locale {
+	first := header
+	if i := strings.IndexByte(header, ','); i >= 0 {
+		first = header[:i]
+	}
+	if i := strings.IndexByte(first, ';'); i >= 0 {
+		first = first[:i]
+	}
+	return Parse(first)
+}
+
+// FormatDate renders t as a locale-appropriate long date, e.g. "2 January,
+// 2026" in English or "2 janvier 2026" in French: day, month name, year,
+// with the comma only where English convention expects one.
+func FormatDate(t time.Time, loc Locale) string {
+	names, ok := months[loc]
+	if !ok {
+		loc = Default
+		names = months[loc]
+	}
+	month := names[t.Month()-1]
+	if loc == English {
+		return t.Format("2 ") + month + t.Format(", 2006")
+	}
+	return t.Format("2 ") + month + t.Format(" 2006")
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// rotatingFile is a minimal size-based log rotator: once the current file
+// would exceed maxSize, it's renamed aside with a numeric suffix and a
+// fresh file takes its place, keeping at most maxBackups old ones.
+type rotatingFile struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSizeMB, maxBackups int) (*rotatingFile, error) {
+	if path == "" {
+		return nil, fmt.Errorf("logging: file output requires a path")
+	}
+	if maxSizeMB <= 0 {
+		maxSizeMB = 100
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("logging: open log file %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("logging: stat log file %s: %w", path, err)
+	}
+
+	return &rotatingFile{
+		path:       path,
+		maxSize:    int64(maxSizeMB) * 1024 * 1024,
+		maxBackups: maxBackups,
+		file:       f,
+		size:       info.Size(),
+	}, nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return fmt.Errorf("logging: close log file for rotation: %w", err)
+	}
+
+	if r.maxBackups <= 0 {
+		f, err := os.OpenFile(r.path, os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("logging: truncate log file: %w", err)
+		}
+		r.file, r.size = f, 0
+		return nil
+	}
+
+	for i := r.maxBackups; i > 1; i-- {
+		newer := backupPath(r.path, i-1)
+		if _, err := os.Stat(newer); err == nil {
+			if err := os.Rename(newer, backupPath(r.path, i)); err != nil {
+				return fmt.Errorf("logging: rotate log file: %w", err)
+			}
+		}
+	}
+	if err := os.Rename(r.path, backupPath(r.path, 1)); err != nil {
+		return fmt.Errorf("logging: rotate log file: %w", err)
+	}
+
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("logging: reopen log file after rotation: %w", err)
+	}
+	r.file, r.size = f, 0
+	return nil
+}
+
+func backupPath(path string, n int) string {
+	ext := filepath.Ext(path)
+	base := path[:len(path)-len(ext)]
+	return fmt.Sprintf("%s.%d%s", base, n, ext)
+}
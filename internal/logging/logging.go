@@ -0,0 +1,68 @@
+// Package logging configures where the process's log output goes: stdout
+// (the default, for a log-collecting sidecar), a rotated file, syslog or
+// an OTLP collector, selected by config.LogConfig.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+// Output selects the destination Setup sends log output to.
+type Output string
+
+const (
+	OutputStdout Output = "stdout"
+	OutputFile   Output = "file"
+	OutputSyslog Output = "syslog"
+	OutputOTLP   Output = "otlp"
+)
+
+// Config controls where Setup sends the process's log output. Only the
+// fields relevant to Output need to be set.
+type Config struct {
+	Output Output
+
+	// FilePath, MaxSizeMB and MaxBackups are consulted when Output is
+	// OutputFile. MaxSizeMB <= 0 defaults to 100; MaxBackups <= 0 keeps no
+	// rotated files around, just truncating in place.
+	FilePath   string
+	MaxSizeMB  int
+	MaxBackups int
+
+	// SyslogAddr is consulted when Output is OutputSyslog. Empty dials the
+	// local syslog daemon instead of a remote one.
+	SyslogAddr string
+
+	// OTLPEndpoint is consulted when Output is OutputOTLP.
+	OTLPEndpoint string
+}
+
+// Setup points the standard library's log package at the destination cfg
+// selects. It's meant to be called once, early in main, before anything
+// else logs.
+func Setup(cfg Config) error {
+	w, err := writer(cfg)
+	if err != nil {
+		return err
+	}
+	log.SetOutput(w)
+	return nil
+}
+
+func writer(cfg Config) (io.Writer, error) {
+	switch cfg.Output {
+	case "", OutputStdout:
+		return os.Stdout, nil
+	case OutputFile:
+		return newRotatingFile(cfg.FilePath, cfg.MaxSizeMB, cfg.MaxBackups)
+	case OutputSyslog:
+		return newSyslogWriter(cfg.SyslogAddr)
+	case OutputOTLP:
+		return newOTLPWriter(cfg.OTLPEndpoint)
+	default:
+		return nil, fmt.Errorf("logging: unknown output %q", cfg.Output)
+	}
+}
@@ -0,0 +1,18 @@
+package logging
+
+import (
+	"fmt"
+	"io"
+)
+
+// newOTLPWriter is a placeholder for exporting logs to an OTLP collector.
+//
+// Like secrets.VaultProvider, this defines the interface boundary so a
+// real exporter (via go.opentelemetry.io/otel) can be dropped in without
+// touching Setup, but doesn't yet ship anything over the wire.
+func newOTLPWriter(endpoint string) (io.Writer, error) {
+	if endpoint == "" {
+		return nil, fmt.Errorf("logging: otlp output requires an endpoint")
+	}
+	return nil, fmt.Errorf("logging: otlp export: not yet implemented (endpoint=%s)", endpoint)
+}
@@ -0,0 +1,23 @@
+package logging
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// newSyslogWriter dials the syslog daemon at addr over TCP, or the local
+// daemon if addr is empty.
+func newSyslogWriter(addr string) (*syslog.Writer, error) {
+	if addr == "" {
+		w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, "ntumiwa")
+		if err != nil {
+			return nil, fmt.Errorf("logging: dial local syslog: %w", err)
+		}
+		return w, nil
+	}
+	w, err := syslog.Dial("tcp", addr, syslog.LOG_INFO|syslog.LOG_DAEMON, "ntumiwa")
+	if err != nil {
+		return nil, fmt.Errorf("logging: dial syslog at %s: %w", addr, err)
+	}
+	return w, nil
+}
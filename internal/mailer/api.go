@@ -0,0 +1,60 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// APISender delivers mail through an HTTP transactional email API instead
+// of speaking SMTP directly. The payload shape here — {from, to, subject,
+// html, text} JSON with a bearer token — is generic enough for most
+// providers; one with a different contract needs its own Sender.
+type APISender struct {
+	Endpoint string
+	APIKey   string
+	From     string
+	Client   *http.Client
+}
+
+type apiPayload struct {
+	From    string   `json:"from"`
+	To      []string `json:"to"`
+	Subject string   `json:"subject"`
+	HTML    string   `json:"html"`
+	Text    string   `json:"text"`
+}
+
+// Send posts msg to Endpoint as JSON, authenticated with APIKey.
+func (s *APISender) Send(ctx context.Context, msg Message) error {
+	body, err := json.Marshal(apiPayload{
+		From:    s.From,
+		To:      msg.To,
+		Subject: msg.Subject,
+		HTML:    msg.HTML,
+		Text:    msg.Text,
+	})
+	if err != nil {
+		return fmt.Errorf("mailer: marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("mailer: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.APIKey)
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return fmt.Errorf("mailer: send: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("mailer: send: provider returned %s", resp.Status)
+	}
+	return nil
+}
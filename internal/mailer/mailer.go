@@ -0,0 +1,79 @@
+// Package mailer sends templated email through a pluggable Sender: SMTP
+// for a directly-configured relay, or an HTTP transactional email API.
+// Templates ship for contact-form notifications, password resets,
+// newsletter confirmations and the upcoming-events digest, but only the
+// digest (event_digest) is loaded and sent, by cmd/api's event-reminders
+// task; nothing renders the other three.
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/smtp"
+)
+
+// Message is a rendered email ready to send.
+type Message struct {
+	To      []string
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Sender delivers a Message.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Kind selects a Sender implementation via config.
+type Kind string
+
+const (
+	KindSMTP Kind = "smtp"
+	KindAPI  Kind = "api"
+)
+
+// Config configures whichever Sender Kind selects.
+type Config struct {
+	Kind Kind
+	From string
+
+	SMTPHost string
+	SMTPPort int
+	SMTPUser string
+	SMTPPwd  string
+
+	APIEndpoint string
+	APIKey      string
+}
+
+// New builds the Sender selected by cfg.Kind.
+func New(cfg Config) (Sender, error) {
+	switch cfg.Kind {
+	case "", KindSMTP:
+		return &SMTPSender{
+			Addr: fmt.Sprintf("%s:%d", cfg.SMTPHost, cfg.SMTPPort),
+			Auth: smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPwd, cfg.SMTPHost),
+			From: cfg.From,
+		}, nil
+	case KindAPI:
+		return &APISender{
+			Endpoint: cfg.APIEndpoint,
+			APIKey:   cfg.APIKey,
+			From:     cfg.From,
+			Client:   http.DefaultClient,
+		}, nil
+	default:
+		return nil, &UnknownKindError{Kind: cfg.Kind}
+	}
+}
+
+// UnknownKindError is returned by New for an unrecognised Kind.
+type UnknownKindError struct {
+	Kind Kind
+}
+
+func (e *UnknownKindError) Error() string {
+	return "mailer: unknown sender kind " + string(e.Kind)
+}
@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPSender delivers mail through a directly-configured SMTP relay.
+type SMTPSender struct {
+	Addr string
+	Auth smtp.Auth
+	From string
+}
+
+// Send builds a multipart/alternative message (a plain-text part and an
+// HTML part) and hands it to the relay at Addr. The stdlib's smtp package
+// has no context support, so ctx only bounds callers that wrap Send with
+// their own timeout.
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if err := smtp.SendMail(s.Addr, s.Auth, s.From, msg.To, buildMIME(s.From, msg)); err != nil {
+		return fmt.Errorf("mailer: smtp send: %w", err)
+	}
+	return nil
+}
+
+// mimeBoundary separates the text and HTML parts of the multipart message
+// built by buildMIME. It doesn't need to be unpredictable: it only has to
+// not collide with the message bodies passed in, which are always our own
+// rendered templates.
+const mimeBoundary = "ntumiwa-mail-boundary"
+
+// buildMIME assembles a minimal multipart/alternative RFC 5322 message
+// with a text part and an HTML part.
+func buildMIME(from string, msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", msg.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&b, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mimeBoundary)
+	fmt.Fprintf(&b, "--%s\r\n", mimeBoundary)
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n\r\n", msg.Text)
+	fmt.Fprintf(&b, "--%s\r\n", mimeBoundary)
+	fmt.Fprintf(&b, "Content-Type: text/html; charset=UTF-8\r\n\r\n%s\r\n\r\n", msg.HTML)
+	fmt.Fprintf(&b, "--%s--\r\n", mimeBoundary)
+	return []byte(b.String())
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	texttemplate "text/template"
+)
+
+//go:embed templates/*.html templates/*.txt
+var templateFS embed.FS
+
+// Template renders one named email as both an HTML and a plain-text part
+// from the same data, so a caller doesn't have to keep the two in sync by
+// hand.
+type Template struct {
+	Subject string
+	html    *template.Template
+	text    *texttemplate.Template
+}
+
+// LoadTemplate parses templates/<name>.html and templates/<name>.txt into
+// a Template with the given subject line.
+func LoadTemplate(name, subject string) (*Template, error) {
+	html, err := template.ParseFS(templateFS, "templates/"+name+".html")
+	if err != nil {
+		return nil, fmt.Errorf("mailer: parse %s.html: %w", name, err)
+	}
+	text, err := texttemplate.ParseFS(templateFS, "templates/"+name+".txt")
+	if err != nil {
+		return nil, fmt.Errorf("mailer: parse %s.txt: %w", name, err)
+	}
+	return &Template{Subject: subject, html: html, text: text}, nil
+}
+
+// Render executes both parts against data and addresses the result to.
+func (t *Template) Render(to []string, data interface{}) (Message, error) {
+	var htmlBuf, textBuf bytes.Buffer
+	if err := t.html.Execute(&htmlBuf, data); err != nil {
+		return Message{}, fmt.Errorf("mailer: render html: %w", err)
+	}
+	if err := t.text.Execute(&textBuf, data); err != nil {
+		return Message{}, fmt.Errorf("mailer: render text: %w", err)
+	}
+	return Message{
+		To:      to,
+		Subject: t.Subject,
+		HTML:    htmlBuf.String(),
+		Text:    textBuf.String(),
+	}, nil
+}
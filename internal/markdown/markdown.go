@@ -0,0 +1,61 @@
+// Package markdown renders a small, deliberately limited subset of
+// Markdown to HTML, safe to embed directly in a page without a separate
+// sanitization pass: paragraphs, **bold**, *italic*, `code`, and
+// [text](url) links restricted to http/https. Anything else in the
+// source is escaped literally rather than interpreted.
+package markdown
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	linkPattern   = regexp.MustCompile(`\[([^\]]+)\]\((https?://[^\s)]+)\)`)
+	boldPattern   = regexp.MustCompile(`\*\*(.+?)\*\*`)
+	italicPattern = regexp.MustCompile(`\*(.+?)\*`)
+	codePattern   = regexp.MustCompile("`([^`]+)`")
+)
+
+// ToHTML renders src to HTML. Blank lines separate paragraphs; a single
+// newline within a paragraph becomes a line break.
+func ToHTML(src string) string {
+	paragraphs := strings.Split(strings.TrimSpace(src), "\n\n")
+	rendered := make([]string, 0, len(paragraphs))
+	for _, p := range paragraphs {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		rendered = append(rendered, "<p>"+renderInline(p)+"</p>")
+	}
+	return strings.Join(rendered, "\n")
+}
+
+// renderInline escapes line, then re-introduces the handful of tags the
+// package supports. Links are pulled out and rendered against the raw
+// (unescaped) text first and swapped back in by placeholder afterwards,
+// so their href and text are each escaped exactly once.
+func renderInline(line string) string {
+	var links []string
+	line = linkPattern.ReplaceAllStringFunc(line, func(m string) string {
+		groups := linkPattern.FindStringSubmatch(m)
+		href := html.EscapeString(groups[2])
+		text := html.EscapeString(groups[1])
+		links = append(links, `<a href="`+href+`" rel="nofollow noopener">`+text+`</a>`)
+		return fmt.Sprintf("\x00%d\x00", len(links)-1)
+	})
+
+	escaped := html.EscapeString(line)
+	escaped = codePattern.ReplaceAllString(escaped, "<code>$1</code>")
+	escaped = boldPattern.ReplaceAllString(escaped, "<strong>$1</strong>")
+	escaped = italicPattern.ReplaceAllString(escaped, "<em>$1</em>")
+	escaped = strings.ReplaceAll(escaped, "\n", "<br>")
+
+	for i, a := range links {
+		escaped = strings.ReplaceAll(escaped, fmt.Sprintf("\x00%d\x00", i), a)
+	}
+	return escaped
+}
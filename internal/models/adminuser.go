@@ -0,0 +1,15 @@
+package models
+
+// AdminUser is an account that can authenticate against the admin
+// dashboard. There's no session subsystem consuming this yet (see
+// internal/auth), and no HTTP handler either — it's managed entirely
+// through cmd/ntumiwactl for now, starting with bootstrapping the first
+// admin account.
+type AdminUser struct {
+	ID           int64    `json:"id"`
+	Email        string   `json:"email"`
+	PasswordHash string   `json:"-"`
+	Active       bool     `json:"active"`
+	CreatedAt    DateTime `json:"created_at"`
+	UpdatedAt    DateTime `json:"updated_at"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// CalendarHold is the provenance record for a draft event that was
+// created, or last refreshed, from a VEVENT on an external .ics calendar
+// (a manager's hold calendar, typically). It lets a re-sync tell "already
+// imported this UID, check for changes" apart from "never seen this UID
+// before", and lets the admin UI show where an otherwise-ordinary draft
+// event actually came from.
+type CalendarHold struct {
+	ID          int64    `json:"id"`
+	ExternalUID string   `json:"external_uid"`
+	SourceURL   string   `json:"source_url"`
+	EventID     int64    `json:"event_id"`
+	Summary     string   `json:"summary"`
+	StartsAt    DateTime `json:"starts_at"`
+	SyncedAt    DateTime `json:"synced_at"`
+	CreatedAt   DateTime `json:"created_at"`
+}
+
+// CalendarHoldRequest is the internal shape used to record or refresh a
+// CalendarHold from a freshly parsed VEVENT. It isn't decoded from JSON;
+// icalimport.Importer is the only caller, so there's no need to route it
+// through Optional fields the way an API-facing request would.
+type CalendarHoldRequest struct {
+	ExternalUID string
+	SourceURL   string
+	EventID     int64
+	Summary     string
+	StartsAt    time.Time
+}
@@ -0,0 +1,103 @@
+package models
+
+// Programme is an ordered set of pieces performed at an event. Pieces is
+// populated by whichever db layer call returns the programme; it isn't a
+// column on the programmes table itself, since the running order lives
+// in the programme_pieces join table.
+type Programme struct {
+	ID        int64            `json:"id"`
+	Title     string           `json:"title"`
+	Pieces    []ProgrammePiece `json:"pieces,omitempty"`
+	CreatedBy string           `json:"created_by,omitempty"`
+	UpdatedBy string           `json:"updated_by,omitempty"`
+	CreatedAt DateTime         `json:"created_at"`
+	UpdatedAt DateTime         `json:"updated_at"`
+	DeletedAt *DateTime        `json:"deleted_at,omitempty"`
+}
+
+// Piece is a single work in the repertoire catalogue.
+type Piece struct {
+	ID         int64     `json:"id"`
+	Title      string    `json:"title"`
+	ComposerID int64     `json:"composer_id"`
+	CreatedBy  string    `json:"created_by,omitempty"`
+	UpdatedBy  string    `json:"updated_by,omitempty"`
+	CreatedAt  DateTime  `json:"created_at"`
+	UpdatedAt  DateTime  `json:"updated_at"`
+	DeletedAt  *DateTime `json:"deleted_at,omitempty"`
+}
+
+// PieceRequest is the payload accepted by CreatePiece. Composer is
+// mutually exclusive with ComposerID: give ComposerID to credit a
+// composer that already exists, or Composer to have one created in the
+// same transaction and credited immediately, so entering a new work
+// doesn't first require a separate round trip to create its composer and
+// copy back the id.
+type PieceRequest struct {
+	Title      string                    `json:"title" validate:"required,max=200"`
+	ComposerID Optional[int64]           `json:"composer_id"`
+	Composer   Optional[ComposerRequest] `json:"composer"`
+}
+
+// Composer is a composer credited on one or more pieces.
+type Composer struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt DateTime  `json:"created_at"`
+	UpdatedAt DateTime  `json:"updated_at"`
+	DeletedAt *DateTime `json:"deleted_at,omitempty"`
+}
+
+// ComposerRequest is the payload accepted by CreateComposer, and by
+// PieceRequest.Composer for creating one inline.
+type ComposerRequest struct {
+	Name string `json:"name" validate:"required,max=200"`
+}
+
+// Recording is a released recording of a piece in the discography.
+// StreamingLinks is populated by whichever db layer call returns the
+// recording; it isn't a column on the recordings table itself, since a
+// recording can be linked from more than one streaming provider.
+type Recording struct {
+	ID             int64                    `json:"id"`
+	Title          string                   `json:"title"`
+	PieceID        int64                    `json:"piece_id"`
+	ReleaseDate    *DateTime                `json:"release_date,omitempty"`
+	StreamingLinks []RecordingStreamingLink `json:"streaming_links,omitempty"`
+	CreatedBy      string                   `json:"created_by,omitempty"`
+	UpdatedBy      string                   `json:"updated_by,omitempty"`
+	CreatedAt      DateTime                 `json:"created_at"`
+	UpdatedAt      DateTime                 `json:"updated_at"`
+	DeletedAt      *DateTime                `json:"deleted_at,omitempty"`
+}
+
+// Venue is a performance venue. Media is populated by whichever db layer
+// call returns the venue; it isn't a column on the venues table itself,
+// since a venue can have more than one photo. Timezone is the IANA zone
+// name (e.g. "America/New_York") events at this venue are local to; an
+// empty Timezone means it hasn't been set, and any event time-of-day at
+// this venue is shown as-is with no zone conversion.
+type Venue struct {
+	ID          int64        `json:"id"`
+	Name        string       `json:"name"`
+	City        string       `json:"city"`
+	Country     string       `json:"country"`
+	Description string       `json:"description,omitempty"`
+	Timezone    string       `json:"timezone,omitempty"`
+	Media       []VenueMedia `json:"media,omitempty"`
+	CreatedBy   string       `json:"created_by,omitempty"`
+	UpdatedBy   string       `json:"updated_by,omitempty"`
+	CreatedAt   DateTime     `json:"created_at"`
+	UpdatedAt   DateTime     `json:"updated_at"`
+	DeletedAt   *DateTime    `json:"deleted_at,omitempty"`
+}
+
+// VenueRequest is the payload accepted by CreateVenue, and by
+// EventRequest.Venue for creating one inline.
+type VenueRequest struct {
+	Name        string `json:"name" validate:"required,max=200"`
+	City        string `json:"city"`
+	Country     string `json:"country"`
+	Description string `json:"description"`
+	Timezone    string `json:"timezone"`
+}
@@ -0,0 +1,168 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// dateLayout is the calendar-date-only format Date accepts and produces.
+const dateLayout = "2006-01-02"
+
+// Date is a calendar date with no time-of-day component, such as an
+// event's date. It marshals as "2006-01-02" rather than a full RFC3339
+// timestamp, and scans/binds against date columns like a plain time.Time,
+// so callers don't have to carry a *time.Time and treat its zero value as
+// an implicit "unset"; EventRequest's optional fields use Optional for that.
+type Date time.Time
+
+// Time returns d as a time.Time, for arithmetic and formatting.
+func (d Date) Time() time.Time { return time.Time(d) }
+
+// IsZero reports whether d is the zero Date.
+func (d Date) IsZero() bool { return time.Time(d).IsZero() }
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Time(d).Format(dateLayout) + `"`), nil
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" || s == "" {
+		*d = Date{}
+		return nil
+	}
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return fmt.Errorf("models: invalid date %q: %w", s, err)
+	}
+	*d = Date(t)
+	return nil
+}
+
+// Scan implements sql.Scanner, so a Date can be read directly from a date
+// column via pgx's row scanning.
+func (d *Date) Scan(value interface{}) error {
+	if value == nil {
+		*d = Date{}
+		return nil
+	}
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into Date", value)
+	}
+	*d = Date(t)
+	return nil
+}
+
+// Value implements driver.Valuer, so a Date binds as a query argument the
+// same way a time.Time would.
+func (d Date) Value() (driver.Value, error) {
+	return time.Time(d), nil
+}
+
+// timeOfDayLayout is the wall-clock-only format TimeOfDay accepts and
+// produces, with no date or zone component.
+const timeOfDayLayout = "15:04"
+
+// TimeOfDay is a time of day with no date or zone attached, such as an
+// event's start time. It marshals as "15:04" and scans/binds against a
+// time-only column like a plain time.Time, the same way Date does
+// against a date column.
+type TimeOfDay time.Time
+
+// Time returns t as a time.Time, for arithmetic and formatting.
+func (t TimeOfDay) Time() time.Time { return time.Time(t) }
+
+// IsZero reports whether t is the zero TimeOfDay.
+func (t TimeOfDay) IsZero() bool { return time.Time(t).IsZero() }
+
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Time(t).Format(timeOfDayLayout) + `"`), nil
+}
+
+func (t *TimeOfDay) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" || s == "" {
+		*t = TimeOfDay{}
+		return nil
+	}
+	parsed, err := time.Parse(timeOfDayLayout, s)
+	if err != nil {
+		return fmt.Errorf("models: invalid time %q: %w", s, err)
+	}
+	*t = TimeOfDay(parsed)
+	return nil
+}
+
+// Scan implements sql.Scanner, so a TimeOfDay can be read directly from
+// a time-only column via pgx's row scanning.
+func (t *TimeOfDay) Scan(value interface{}) error {
+	if value == nil {
+		*t = TimeOfDay{}
+		return nil
+	}
+	tm, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into TimeOfDay", value)
+	}
+	*t = TimeOfDay(tm)
+	return nil
+}
+
+// Value implements driver.Valuer, so a TimeOfDay binds as a query
+// argument the same way a time.Time would.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return time.Time(t), nil
+}
+
+// DateTime is a full timestamp, such as a row's created_at or updated_at.
+// It marshals as RFC3339 and scans/binds against timestamp columns like a
+// plain time.Time.
+type DateTime time.Time
+
+// Time returns dt as a time.Time, for arithmetic and formatting.
+func (dt DateTime) Time() time.Time { return time.Time(dt) }
+
+// IsZero reports whether dt is the zero DateTime.
+func (dt DateTime) IsZero() bool { return time.Time(dt).IsZero() }
+
+func (dt DateTime) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + time.Time(dt).Format(time.RFC3339) + `"`), nil
+}
+
+func (dt *DateTime) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "null" || s == "" {
+		*dt = DateTime{}
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("models: invalid timestamp %q: %w", s, err)
+	}
+	*dt = DateTime(t)
+	return nil
+}
+
+// Scan implements sql.Scanner, so a DateTime can be read directly from a
+// timestamp column via pgx's row scanning.
+func (dt *DateTime) Scan(value interface{}) error {
+	if value == nil {
+		*dt = DateTime{}
+		return nil
+	}
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into DateTime", value)
+	}
+	*dt = DateTime(t)
+	return nil
+}
+
+// Value implements driver.Valuer, so a DateTime binds as a query argument
+// the same way a time.Time would.
+func (dt DateTime) Value() (driver.Value, error) {
+	return time.Time(dt), nil
+}
@@ -0,0 +1,100 @@
+// Package models defines the shapes shared by the db layer and the API
+// handlers: what an Event, Programme, Piece, Composer and Venue look like,
+// independent of how they're stored or served. It is the single
+// canonical source for these types; the generated db/sqlc package is a
+// separate, deliberately unrelated set of raw row types scanned straight
+// off query results, not an alternate copy of these.
+package models
+
+// Event is a single concert or appearance. TicketOffers, Media and
+// Collaborators are populated by whichever db layer call returns the
+// event; none of them is a column on the events table itself, since an
+// event can be sold through more than one outlet, can carry more than one
+// attachment, and can credit more than one guest artist. Visibility is
+// orthogonal to Status: a draft or a published event can each be public,
+// unlisted or private. TourID is optional and groups the event with the
+// other events of the same tour; unlike ProgrammeID and VenueID, nothing
+// about an event depends on it having one. SeasonID is likewise optional,
+// but unlike TourID it's normally set for you: if a request doesn't give
+// one explicitly, the db layer assigns whichever season's date range
+// covers EventDate. ProfileID is optional too, and only meaningful once a
+// deployment has more than one Profile; an event with no ProfileID is
+// still shown, the same as before Profile existed. StartTime is optional
+// and wall-clock only; combined with the venue's Timezone it's how the
+// public feed shows the event's correct local start time, and a nil
+// StartTime means only the date is known. CancellationReason is only
+// ever non-empty while Status is StatusCancelled; it's cleared again
+// the moment the event transitions to anything else.
+type Event struct {
+	ID                 int64               `json:"id"`
+	Slug               string              `json:"slug"`
+	Title              string              `json:"title"`
+	Status             EventStatus         `json:"status"`
+	CancellationReason string              `json:"cancellation_reason,omitempty"`
+	Visibility         EventVisibility     `json:"visibility"`
+	EventDate          Date                `json:"event_date"`
+	StartTime          *TimeOfDay          `json:"start_time,omitempty"`
+	VenueID            *int64              `json:"venue_id,omitempty"`
+	ProgrammeID        *int64              `json:"programme_id,omitempty"`
+	TourID             *int64              `json:"tour_id,omitempty"`
+	SeasonID           *int64              `json:"season_id,omitempty"`
+	ProfileID          *int64              `json:"profile_id,omitempty"`
+	TicketOffers       []TicketOffer       `json:"ticket_offers,omitempty"`
+	Media              []EventMedia        `json:"media,omitempty"`
+	Collaborators      []EventCollaborator `json:"collaborators,omitempty"`
+	Notes              string              `json:"notes,omitempty"`
+	CreatedBy          string              `json:"created_by,omitempty"`
+	UpdatedBy          string              `json:"updated_by,omitempty"`
+	CreatedAt          DateTime            `json:"created_at"`
+	UpdatedAt          DateTime            `json:"updated_at"`
+	DeletedAt          *DateTime           `json:"deleted_at,omitempty"`
+}
+
+// EventRequest is the payload accepted by the create/update event
+// endpoints. Title and EventDate are required on every request. The
+// remaining fields are Optional: absent means "leave unchanged" on
+// update (or, for Visibility, "default to public" on create), and an
+// explicit JSON null means "clear it". TicketOffers, Media and
+// Collaborators, when set, replace the event's entire set of offers,
+// attachments or credits respectively; there's no way to patch a single
+// offer, attachment or credit through EventRequest. SeasonID is the one
+// exception to "absent means leave unchanged": leaving it absent tells
+// the db layer to (re)assign a season automatically from EventDate,
+// so it has to be set explicitly to pin an event to a season EventDate
+// alone wouldn't imply. Venue, when set, creates a new venue in the same
+// transaction and uses it in place of VenueID, so booking a one-off event
+// at a venue that doesn't exist yet doesn't require a separate create-venue
+// call first.
+type EventRequest struct {
+	Title         string                               `json:"title" validate:"required,max=200"`
+	EventDate     Date                                 `json:"event_date" validate:"required"`
+	StartTime     Optional[TimeOfDay]                  `json:"start_time"`
+	Visibility    Optional[EventVisibility]            `json:"visibility"`
+	VenueID       Optional[int64]                      `json:"venue_id"`
+	Venue         Optional[VenueRequest]               `json:"venue"`
+	ProgrammeID   Optional[int64]                      `json:"programme_id"`
+	TourID        Optional[int64]                      `json:"tour_id"`
+	SeasonID      Optional[int64]                      `json:"season_id"`
+	ProfileID     Optional[int64]                      `json:"profile_id"`
+	TicketOffers  Optional[[]TicketOfferRequest]       `json:"ticket_offers"`
+	Media         Optional[[]EventMediaRequest]        `json:"media"`
+	Collaborators Optional[[]EventCollaboratorRequest] `json:"collaborators"`
+	Notes         Optional[string]                     `json:"notes" validate:"max=10000"`
+}
+
+// EventRollForwardRequest is the payload accepted by
+// POST /events/{id}/roll-forward. Years is how far forward to shift the
+// clone's EventDate and defaults to 1 when absent, e.g. "put next year's
+// instance of this concert on the calendar."
+type EventRollForwardRequest struct {
+	Years Optional[int] `json:"years"`
+}
+
+// EventDuplicateRequest is the payload accepted by
+// POST /events/{id}/duplicate. NewDate overrides the clone's EventDate;
+// EventDate can't be null, so leaving NewDate absent just starts the
+// clone on the same date as the original, for the caller to change once
+// the two are distinguishable in the admin UI.
+type EventDuplicateRequest struct {
+	NewDate Optional[Date] `json:"new_date"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "encoding/json"
+
+// EventAuditEntry is one recorded change to an event: who made it, when,
+// and what changed. Diff is a JSON object of the fields that changed,
+// each holding its before/after value; for a create it holds every
+// field's starting value with no meaningful "before". It's returned
+// as-is rather than unmarshaled into a typed diff, since the shape of
+// what changed varies entry to entry.
+type EventAuditEntry struct {
+	ID        int64           `json:"id"`
+	EventID   int64           `json:"event_id"`
+	Action    string          `json:"action"`
+	Actor     string          `json:"actor,omitempty"`
+	Diff      json.RawMessage `json:"diff"`
+	CreatedAt DateTime        `json:"created_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+// CollaboratorRoleConductor, CollaboratorRoleOrchestra and
+// CollaboratorRoleDuoPartner are the recognized values of
+// EventCollaborator.Role. Like MediaKindPoster, this is a plain string
+// with a oneof validation rule rather than a distinct type.
+const (
+	CollaboratorRoleConductor  = "conductor"
+	CollaboratorRoleOrchestra  = "orchestra"
+	CollaboratorRoleDuoPartner = "duo_partner"
+)
+
+// EventCollaborator is a guest artist credited on an event: a conductor,
+// an accompanying orchestra, or a duo partner. It's a one-to-many
+// attachment scoped to the event it's credited on, distinct from a
+// general collaborators catalogue: a guest credit is usually a one-off
+// for a single performance, not a record that needs to be tracked and
+// reused the way a Composer or Piece is.
+type EventCollaborator struct {
+	ID        int64    `json:"id"`
+	Name      string   `json:"name"`
+	Role      string   `json:"role"`
+	CreatedAt DateTime `json:"created_at"`
+	UpdatedAt DateTime `json:"updated_at"`
+}
+
+// EventCollaboratorRequest is one entry in EventRequest.Collaborators.
+type EventCollaboratorRequest struct {
+	Name string `json:"name" validate:"required,max=200"`
+	Role string `json:"role" validate:"required,oneof=conductor|orchestra|duo_partner"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// EventDebrief holds the private, post-event record of what actually
+// happened at a show: how many people turned up, freeform notes for
+// internal review, and any departures from the planned setlist. It's a
+// separate resource from Event rather than fields on it, so it never
+// appears in the public performances feed and is only ever written once
+// EventDate has passed.
+type EventDebrief struct {
+	EventID           int64    `json:"event_id"`
+	ActualAttendance  *int64   `json:"actual_attendance,omitempty"`
+	Notes             string   `json:"notes,omitempty"`
+	SetlistDeviations string   `json:"setlist_deviations,omitempty"`
+	UpdatedBy         string   `json:"updated_by,omitempty"`
+	UpdatedAt         DateTime `json:"updated_at"`
+}
+
+// EventDebriefRequest is the payload accepted by PUT /events/{id}/debrief.
+// Every field is Optional: absent leaves the current value unchanged (or
+// the zero value, on the first debrief), and an explicit null clears it.
+type EventDebriefRequest struct {
+	ActualAttendance  Optional[int64]  `json:"actual_attendance"`
+	Notes             Optional[string] `json:"notes" validate:"max=10000"`
+	SetlistDeviations Optional[string] `json:"setlist_deviations" validate:"max=10000"`
+}
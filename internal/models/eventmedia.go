@@ -0,0 +1,41 @@
+package models
+
+// MediaKindPoster, MediaKindProgramme and MediaKindVideo are the recognized
+// values of EventMedia.Kind. Unlike TicketStatus and EventStatus this isn't
+// a distinct type: nothing scans it out of a driver.Value or needs a
+// String() method yet, so a plain string with a oneof validation rule is
+// enough.
+const (
+	MediaKindPoster    = "poster"
+	MediaKindProgramme = "programme_pdf"
+	MediaKindVideo     = "video"
+)
+
+// EventMedia is one piece of uploaded media attached to an event: a
+// poster image, the printed programme as a PDF, or a video embed. Like
+// TicketOffer, it's a one-to-many attachment rather than a column on
+// Event, since an event can have a poster, a programme and a video all at
+// once. Title, DurationSeconds, ThumbnailURL and MetadataFetchedAt only
+// ever get set on a MediaKindVideo row: they start unset at creation and
+// are filled in asynchronously once the youtube job fetches them from the
+// YouTube Data API; MetadataFetchedAt is nil until the first successful
+// fetch.
+type EventMedia struct {
+	ID                int64     `json:"id"`
+	Kind              string    `json:"kind"`
+	URL               string    `json:"url"`
+	Label             string    `json:"label,omitempty"`
+	Title             string    `json:"title,omitempty"`
+	DurationSeconds   *int32    `json:"duration_seconds,omitempty"`
+	ThumbnailURL      string    `json:"thumbnail_url,omitempty"`
+	MetadataFetchedAt *DateTime `json:"metadata_fetched_at,omitempty"`
+	CreatedAt         DateTime  `json:"created_at"`
+	UpdatedAt         DateTime  `json:"updated_at"`
+}
+
+// EventMediaRequest is one entry in EventRequest.Media.
+type EventMediaRequest struct {
+	Kind  string `json:"kind" validate:"required,oneof=poster|programme_pdf|video"`
+	URL   string `json:"url" validate:"required,url,max=2048"`
+	Label string `json:"label" validate:"max=200"`
+}
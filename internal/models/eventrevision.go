@@ -0,0 +1,16 @@
+package models
+
+import "encoding/json"
+
+// EventRevision is a full snapshot of an event's content taken right
+// before an update overwrote it, so RollbackEventRevision has something
+// to restore. Unlike EventAuditEntry's diff, Snapshot is the complete
+// prior state, not just what changed.
+type EventRevision struct {
+	ID        int64           `json:"id"`
+	EventID   int64           `json:"event_id"`
+	Revision  int             `json:"revision"`
+	Snapshot  json.RawMessage `json:"snapshot"`
+	CreatedBy string          `json:"created_by,omitempty"`
+	CreatedAt DateTime        `json:"created_at"`
+}
@@ -0,0 +1,11 @@
+package models
+
+// GoogleCalendarSync is the provenance record linking a published event to
+// the Google Calendar event it was mirrored to, so a later sync updates the
+// same calendar entry instead of creating a duplicate, and knows what to
+// delete once the event is unpublished or trashed.
+type GoogleCalendarSync struct {
+	EventID       int64    `json:"event_id"`
+	GoogleEventID string   `json:"google_event_id"`
+	SyncedAt      DateTime `json:"synced_at"`
+}
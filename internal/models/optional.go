@@ -0,0 +1,43 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Optional wraps a request field so JSON decoding can tell apart the key
+// being absent from the payload, present with a null, and present with a
+// value — three states a bare *T can't distinguish without a comment
+// explaining which nil means what. Set is false when the key was never
+// in the payload; UnmarshalJSON is only called for keys that are present,
+// so encoding/json leaves an absent Optional at its zero value.
+type Optional[T any] struct {
+	Set   bool
+	Null  bool
+	Value T
+}
+
+// Get returns the wrapped value and whether it was explicitly provided:
+// true only when the key was present and not null. Callers that need to
+// tell "absent" apart from "null" (an update that should leave a field
+// unchanged versus one that should clear it) read Set and Null directly
+// instead.
+func (o Optional[T]) Get() (T, bool) {
+	return o.Value, o.Set && !o.Null
+}
+
+func (o *Optional[T]) UnmarshalJSON(b []byte) error {
+	o.Set = true
+	if bytes.Equal(b, []byte("null")) {
+		o.Null = true
+		return nil
+	}
+	return json.Unmarshal(b, &o.Value)
+}
+
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.Set || o.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.Value)
+}
@@ -0,0 +1,15 @@
+package models
+
+// Profile is one artist or ensemble member the deployment publishes a
+// catalogue for. Most content is still deployment-wide; Profile exists so
+// events can optionally be scoped to one member of an ensemble or duo
+// instead of every event belonging to a single implied artist. There's no
+// HTTP handler for it yet — it's managed entirely through cmd/ntumiwactl,
+// the same way AdminUser is.
+type Profile struct {
+	ID        int64    `json:"id"`
+	Slug      string   `json:"slug"`
+	Name      string   `json:"name"`
+	CreatedAt DateTime `json:"created_at"`
+	UpdatedAt DateTime `json:"updated_at"`
+}
@@ -0,0 +1,22 @@
+package models
+
+// ProgrammePiece is one piece in a programme's running order. Notes is a
+// markdown blurb about that specific performance of the piece (the
+// arrangement used, a dedication, historical context) rather than
+// anything belonging to the Piece catalogue entry itself, since the same
+// piece can carry a different note in a different programme.
+type ProgrammePiece struct {
+	ID        int64    `json:"id"`
+	PieceID   int64    `json:"piece_id"`
+	Position  int      `json:"position"`
+	Notes     string   `json:"notes,omitempty"`
+	CreatedAt DateTime `json:"created_at"`
+	UpdatedAt DateTime `json:"updated_at"`
+}
+
+// ProgrammePieceRequest is one entry in a call to DB.SetProgrammePieces.
+// Order in the slice becomes Position.
+type ProgrammePieceRequest struct {
+	PieceID int64  `json:"piece_id" validate:"required"`
+	Notes   string `json:"notes" validate:"max=10000"`
+}
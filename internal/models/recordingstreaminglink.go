@@ -0,0 +1,26 @@
+package models
+
+// StreamingProviderSpotify, StreamingProviderAppleMusic and
+// StreamingProviderBandcamp are the streaming providers a
+// RecordingStreamingLink can point at.
+const (
+	StreamingProviderSpotify    = "spotify"
+	StreamingProviderAppleMusic = "apple_music"
+	StreamingProviderBandcamp   = "bandcamp"
+)
+
+// RecordingStreamingLink is a single provider's listing for a recording.
+// ArtworkURL, ProviderReleaseDate and MetadataFetchedAt start unset at
+// creation and are filled in asynchronously once the streamingmeta job
+// fetches them from the provider; MetadataFetchedAt is nil until the first
+// successful fetch.
+type RecordingStreamingLink struct {
+	ID                  int64     `json:"id"`
+	Provider            string    `json:"provider"`
+	URL                 string    `json:"url"`
+	ArtworkURL          string    `json:"artwork_url,omitempty"`
+	ProviderReleaseDate *DateTime `json:"provider_release_date,omitempty"`
+	MetadataFetchedAt   *DateTime `json:"metadata_fetched_at,omitempty"`
+	CreatedAt           DateTime  `json:"created_at"`
+	UpdatedAt           DateTime  `json:"updated_at"`
+}
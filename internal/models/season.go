@@ -0,0 +1,28 @@
+package models
+
+// Season groups events by performing year, e.g. "2024/25", spanning
+// StartsOn to EndsOn. An event joins a season automatically: the db
+// layer assigns whichever season's range covers the event's date unless
+// a request pins SeasonID explicitly. A Season doesn't own its events
+// the way a Programme owns its pieces, so dropping an event from a
+// season is just clearing that field on the event, not deleting
+// anything here.
+type Season struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	StartsOn  Date      `json:"starts_on"`
+	EndsOn    Date      `json:"ends_on"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	CreatedAt DateTime  `json:"created_at"`
+	UpdatedAt DateTime  `json:"updated_at"`
+	DeletedAt *DateTime `json:"deleted_at,omitempty"`
+}
+
+// SeasonRequest is the payload accepted by the create/update season
+// endpoints.
+type SeasonRequest struct {
+	Name     string `json:"name" validate:"required,max=200"`
+	StartsOn Date   `json:"starts_on" validate:"required"`
+	EndsOn   Date   `json:"ends_on" validate:"required"`
+}
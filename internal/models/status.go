@@ -0,0 +1,266 @@
+package models
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// EventStatus is the publication state of an Event: a new event starts as
+// StatusDraft, becomes StatusPublished once it should appear in public
+// listings, and can be moved to StatusArchived once it's over or
+// StatusCancelled if it's off. Moving between statuses is only ever
+// valid along the transitions eventStatusTransitions lists;
+// CanTransitionTo is the single place that decides whether a given move
+// is allowed.
+type EventStatus string
+
+const (
+	StatusDraft     EventStatus = "draft"
+	StatusPublished EventStatus = "published"
+	StatusArchived  EventStatus = "archived"
+	StatusCancelled EventStatus = "cancelled"
+)
+
+// String implements fmt.Stringer.
+func (s EventStatus) String() string { return string(s) }
+
+// Valid reports whether s is one of the known statuses, so a value read
+// from JSON or the database can be rejected before it reaches code that
+// assumes an exhaustive switch has already ruled out anything else.
+func (s EventStatus) Valid() bool {
+	switch s {
+	case StatusDraft, StatusPublished, StatusArchived, StatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// eventStatusTransitions lists, for each status, the statuses it can move
+// to directly. A draft can be published, or cancelled before it ever
+// goes public; a published event is archived once it's over, or
+// cancelled if it's off; an archived event can still be cancelled; and
+// both an archived and a cancelled event can be republished (a postponed show
+// that's back on, say), which CanTransitionTo's caller is expected to
+// treat like any other publish and re-run the same readiness checks.
+// There's no way back to draft: publishing is meant to be a one-way door
+// once an event has been public.
+var eventStatusTransitions = map[EventStatus][]EventStatus{
+	StatusDraft:     {StatusPublished, StatusCancelled},
+	StatusPublished: {StatusArchived, StatusCancelled},
+	StatusArchived:  {StatusPublished, StatusCancelled},
+	StatusCancelled: {StatusPublished},
+}
+
+// CanTransitionTo reports whether moving from s to next is one of the
+// transitions eventStatusTransitions allows.
+func (s EventStatus) CanTransitionTo(next EventStatus) bool {
+	for _, allowed := range eventStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s EventStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + string(s) + `"`), nil
+}
+
+func (s *EventStatus) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	status := EventStatus(str)
+	if !status.Valid() {
+		return fmt.Errorf("models: invalid event status %q", str)
+	}
+	*s = status
+	return nil
+}
+
+// Scan implements sql.Scanner, so an EventStatus can be read directly
+// from the status column via pgx's row scanning.
+func (s *EventStatus) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into EventStatus", value)
+	}
+	*s = EventStatus(str)
+	return nil
+}
+
+// Value implements driver.Valuer, so an EventStatus binds as a query
+// argument the same way a plain string would.
+func (s EventStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// EventVisibility controls who an Event is shown to, independently of
+// Status: a draft can be VisibilityPublic and a published event can be
+// VisibilityUnlisted or VisibilityPrivate. VisibilityUnlisted and
+// VisibilityPrivate events are both excluded from public listings and
+// feeds; the two exist as separate values so the admin can distinguish
+// "not advertised, but still linkable" from "not meant to be shared at
+// all" even though this codebase doesn't yet treat them differently.
+type EventVisibility string
+
+const (
+	VisibilityPublic   EventVisibility = "public"
+	VisibilityUnlisted EventVisibility = "unlisted"
+	VisibilityPrivate  EventVisibility = "private"
+)
+
+// String implements fmt.Stringer.
+func (v EventVisibility) String() string { return string(v) }
+
+// Valid reports whether v is one of the known visibilities.
+func (v EventVisibility) Valid() bool {
+	switch v {
+	case VisibilityPublic, VisibilityUnlisted, VisibilityPrivate:
+		return true
+	default:
+		return false
+	}
+}
+
+func (v EventVisibility) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + string(v) + `"`), nil
+}
+
+func (v *EventVisibility) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	visibility := EventVisibility(str)
+	if !visibility.Valid() {
+		return fmt.Errorf("models: invalid event visibility %q", str)
+	}
+	*v = visibility
+	return nil
+}
+
+// Scan implements sql.Scanner, so an EventVisibility can be read directly
+// from the visibility column via pgx's row scanning.
+func (v *EventVisibility) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into EventVisibility", value)
+	}
+	*v = EventVisibility(str)
+	return nil
+}
+
+// Value implements driver.Valuer, so an EventVisibility binds as a query
+// argument the same way a plain string would.
+func (v EventVisibility) Value() (driver.Value, error) {
+	return string(v), nil
+}
+
+// TicketStatus is what the ticket availability poller last observed at a
+// TicketOffer's URL. TicketStatusUnknown is the default until the poller
+// has probed the offer at least once.
+type TicketStatus string
+
+const (
+	TicketStatusUnknown TicketStatus = "unknown"
+	TicketStatusOnSale  TicketStatus = "on_sale"
+	TicketStatusSoldOut TicketStatus = "sold_out"
+)
+
+// String implements fmt.Stringer.
+func (s TicketStatus) String() string { return string(s) }
+
+// Valid reports whether s is one of the known ticket statuses.
+func (s TicketStatus) Valid() bool {
+	switch s {
+	case TicketStatusUnknown, TicketStatusOnSale, TicketStatusSoldOut:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s TicketStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + string(s) + `"`), nil
+}
+
+func (s *TicketStatus) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	status := TicketStatus(str)
+	if !status.Valid() {
+		return fmt.Errorf("models: invalid ticket status %q", str)
+	}
+	*s = status
+	return nil
+}
+
+// Scan implements sql.Scanner, so a TicketStatus can be read directly from
+// the event_ticket_offers.status column via pgx's row scanning.
+func (s *TicketStatus) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into TicketStatus", value)
+	}
+	*s = TicketStatus(str)
+	return nil
+}
+
+// Value implements driver.Valuer, so a TicketStatus binds as a query
+// argument the same way a plain string would.
+func (s TicketStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// WebhookPayloadMode selects the shape of the payload a WebhookChannel
+// receives. PayloadModeTemplate renders the channel's own
+// TextTemplate/ImageURLTemplate/LinkTemplate; PayloadModeFlat ignores
+// them and sends webhooks.FlatPayload instead, a fixed set of field
+// names a no-code tool like Zapier can map without any templating step.
+type WebhookPayloadMode string
+
+const (
+	PayloadModeTemplate WebhookPayloadMode = "template"
+	PayloadModeFlat     WebhookPayloadMode = "flat"
+)
+
+// String implements fmt.Stringer.
+func (m WebhookPayloadMode) String() string { return string(m) }
+
+// Valid reports whether m is one of the known payload modes.
+func (m WebhookPayloadMode) Valid() bool {
+	switch m {
+	case PayloadModeTemplate, PayloadModeFlat:
+		return true
+	default:
+		return false
+	}
+}
+
+func (m WebhookPayloadMode) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + string(m) + `"`), nil
+}
+
+func (m *WebhookPayloadMode) UnmarshalJSON(b []byte) error {
+	str := strings.Trim(string(b), `"`)
+	mode := WebhookPayloadMode(str)
+	if !mode.Valid() {
+		return fmt.Errorf("models: invalid webhook payload mode %q", str)
+	}
+	*m = mode
+	return nil
+}
+
+// Scan implements sql.Scanner, so a WebhookPayloadMode can be read
+// directly from the payload_mode column via pgx's row scanning.
+func (m *WebhookPayloadMode) Scan(value interface{}) error {
+	str, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("models: cannot scan %T into WebhookPayloadMode", value)
+	}
+	*m = WebhookPayloadMode(str)
+	return nil
+}
+
+// Value implements driver.Valuer, so a WebhookPayloadMode binds as a
+// query argument the same way a plain string would.
+func (m WebhookPayloadMode) Value() (driver.Value, error) {
+	return string(m), nil
+}
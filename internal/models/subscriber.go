@@ -0,0 +1,11 @@
+package models
+
+// Subscriber is one newsletter signup. It's append-only from the API's
+// perspective: an address can be added, but there's no update or delete
+// path yet, since unsubscribing is handled by the newsletter provider
+// itself once a subscriber is synced there.
+type Subscriber struct {
+	ID        int64    `json:"id"`
+	Email     string   `json:"email"`
+	CreatedAt DateTime `json:"created_at"`
+}
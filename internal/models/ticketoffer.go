@@ -0,0 +1,24 @@
+package models
+
+// TicketOffer is one place tickets for an event can be bought: a labelled
+// link (box office, online, a specific price tier) with its own
+// availability, since many concerts sell through more than one channel at
+// once.
+type TicketOffer struct {
+	ID         int64        `json:"id"`
+	Label      string       `json:"label,omitempty"`
+	URL        string       `json:"url"`
+	PriceCents *int64       `json:"price_cents,omitempty"`
+	Currency   string       `json:"currency,omitempty"`
+	Status     TicketStatus `json:"status"`
+	CreatedAt  DateTime     `json:"created_at"`
+	UpdatedAt  DateTime     `json:"updated_at"`
+}
+
+// TicketOfferRequest is one entry in EventRequest.TicketOffers.
+type TicketOfferRequest struct {
+	Label      string          `json:"label" validate:"max=200"`
+	URL        string          `json:"url" validate:"required,url,max=2048"`
+	PriceCents Optional[int64] `json:"price_cents"`
+	Currency   string          `json:"currency" validate:"max=8"`
+}
@@ -0,0 +1,25 @@
+package models
+
+// Tour groups a run of events into a single named series — a multi-city
+// tour, a festival residency — spanning StartsOn to EndsOn. An event
+// joins a tour by setting its TourID; a Tour doesn't own its events the
+// way a Programme owns its pieces, so dropping an event from a tour is
+// just clearing that field on the event, not deleting anything here.
+type Tour struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	StartsOn  Date      `json:"starts_on"`
+	EndsOn    Date      `json:"ends_on"`
+	CreatedBy string    `json:"created_by,omitempty"`
+	UpdatedBy string    `json:"updated_by,omitempty"`
+	CreatedAt DateTime  `json:"created_at"`
+	UpdatedAt DateTime  `json:"updated_at"`
+	DeletedAt *DateTime `json:"deleted_at,omitempty"`
+}
+
+// TourRequest is the payload accepted by the create/update tour endpoints.
+type TourRequest struct {
+	Name     string `json:"name" validate:"required,max=200"`
+	StartsOn Date   `json:"starts_on" validate:"required"`
+	EndsOn   Date   `json:"ends_on" validate:"required"`
+}
@@ -0,0 +1,12 @@
+package models
+
+// VenueMedia is a photo attached to a venue. Unlike EventMedia it has no
+// Kind: a venue's media is always a photo, so there's nothing to
+// distinguish it from.
+type VenueMedia struct {
+	ID        int64    `json:"id"`
+	URL       string   `json:"url"`
+	Label     string   `json:"label,omitempty"`
+	CreatedAt DateTime `json:"created_at"`
+	UpdatedAt DateTime `json:"updated_at"`
+}
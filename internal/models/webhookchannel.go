@@ -0,0 +1,22 @@
+package models
+
+// WebhookChannel is a social automation endpoint that gets a ready-made post
+// payload whenever an event is published. TextTemplate, ImageURLTemplate, and
+// LinkTemplate are text/template strings rendered against a webhooks.TemplateData
+// value at send time, so each channel can format the same publish event
+// differently; they're ignored when PayloadMode is PayloadModeFlat, since a
+// flat payload has no per-channel formatting to fill in.
+type WebhookChannel struct {
+	ID               int64              `json:"id"`
+	Name             string             `json:"name"`
+	URL              string             `json:"url"`
+	PayloadMode      WebhookPayloadMode `json:"payload_mode"`
+	TextTemplate     string             `json:"text_template,omitempty"`
+	ImageURLTemplate string             `json:"image_url_template,omitempty"`
+	LinkTemplate     string             `json:"link_template,omitempty"`
+	CreatedBy        string             `json:"created_by,omitempty"`
+	UpdatedBy        string             `json:"updated_by,omitempty"`
+	CreatedAt        DateTime           `json:"created_at"`
+	UpdatedAt        DateTime           `json:"updated_at"`
+	DeletedAt        *DateTime          `json:"deleted_at,omitempty"`
+}
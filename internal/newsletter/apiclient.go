@@ -0,0 +1,108 @@
+package newsletter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// buttondownAPIBase is the Buttondown REST endpoint. APIClient talks to it
+// directly rather than through a client library, to avoid pulling one in
+// for two simple calls.
+const buttondownAPIBase = "https://api.buttondown.email/v1"
+
+// APIClient is a Client backed by the real Buttondown API.
+type APIClient struct {
+	APIKey string
+	Client *http.Client
+}
+
+// UpsertSubscriber adds email to the Buttondown subscriber list. A 400 for
+// an address that's already subscribed is treated as success, since the
+// desired end state - "email is on the list" - already holds.
+func (c *APIClient) UpsertSubscriber(ctx context.Context, email string) error {
+	body := map[string]string{"email_address": email}
+	err := c.do(ctx, http.MethodPost, buttondownAPIBase+"/subscribers", body, nil)
+	if apiErr, ok := err.(*apiError); ok && apiErr.StatusCode == http.StatusBadRequest {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("newsletter: upsert subscriber: %w", err)
+	}
+	return nil
+}
+
+// draftEmail is the request/response body shape for a draft email.
+type draftEmail struct {
+	ID      string `json:"id,omitempty"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+	Status  string `json:"status"`
+}
+
+// CreateDraftCampaign creates a draft email in Buttondown with subject and
+// body, and returns its id.
+func (c *APIClient) CreateDraftCampaign(ctx context.Context, subject, body string) (string, error) {
+	var out draftEmail
+	req := draftEmail{Subject: subject, Body: body, Status: "draft"}
+	if err := c.do(ctx, http.MethodPost, buttondownAPIBase+"/emails", req, &out); err != nil {
+		return "", fmt.Errorf("newsletter: create draft campaign: %w", err)
+	}
+	return out.ID, nil
+}
+
+// apiError carries the HTTP status of a failed request, so callers can
+// branch on it (UpsertSubscriber's already-subscribed tolerance) without
+// parsing the error string.
+type apiError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("status %d: %s", e.StatusCode, e.Body)
+}
+
+func (c *APIClient) do(ctx context.Context, method, url string, body, out interface{}) error {
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Token "+c.APIKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &apiError{StatusCode: resp.StatusCode, Body: string(respBody)}
+	}
+	if out != nil {
+		if err := json.Unmarshal(respBody, out); err != nil {
+			return fmt.Errorf("parse response: %w", err)
+		}
+	}
+	return nil
+}
+
+var _ Client = (*APIClient)(nil)
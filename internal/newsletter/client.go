@@ -0,0 +1,12 @@
+package newsletter
+
+import "context"
+
+// Client is the newsletter provider surface Syncer depends on, so it can
+// be faked in place of a real APIClient. Any provider with a subscriber
+// upsert call and a draft-campaign call (Mailchimp, Buttondown, ...) can
+// implement it.
+type Client interface {
+	UpsertSubscriber(ctx context.Context, email string) error
+	CreateDraftCampaign(ctx context.Context, subject, body string) (campaignID string, err error)
+}
@@ -0,0 +1,108 @@
+// Package newsletter keeps a newsletter provider in sync with the app, via
+// the outbox: internal/db enqueues a newsletter_subscriber_sync job every
+// time a subscriber signs up, and a newsletter_draft_campaign job every
+// time an event is updated, and Syncer's two handlers re-fetch their
+// respective row's current state before acting on it.
+package newsletter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Syncer depends on.
+type Store interface {
+	GetSubscriber(ctx context.Context, id int64) (*models.Subscriber, error)
+	GetEvent(ctx context.Context, id int64) (*models.Event, error)
+	HasNewsletterCampaign(ctx context.Context, eventID int64) (bool, error)
+	RecordNewsletterCampaign(ctx context.Context, eventID int64, campaignID string) error
+}
+
+// Syncer syncs subscribers to, and drafts campaigns in, the configured
+// newsletter provider. Its two Handle methods are registered under
+// separate job kinds, since they act on different rows and never need to
+// run together.
+type Syncer struct {
+	Store  Store
+	Client Client
+}
+
+// subscriberSyncPayload is the outbox payload enqueued by
+// internal/db/subscriber.go: just the subscriber id, since HandleSubscriberSync
+// re-derives everything else from the row's current state.
+type subscriberSyncPayload struct {
+	SubscriberID int64 `json:"subscriber_id"`
+}
+
+// HandleSubscriberSync implements jobs.Handler: it decodes payload,
+// re-fetches the subscriber's current state, and upserts it into the
+// provider's list. Re-fetching rather than trusting the payload means a
+// job delayed past the point the subscriber was somehow removed just finds
+// nothing and returns.
+func (s *Syncer) HandleSubscriberSync(ctx context.Context, payload []byte) error {
+	var p subscriberSyncPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("newsletter: unmarshal subscriber sync payload: %w", err)
+	}
+
+	subscriber, err := s.Store.GetSubscriber(ctx, p.SubscriberID)
+	if err != nil {
+		return fmt.Errorf("newsletter: get subscriber %d: %w", p.SubscriberID, err)
+	}
+
+	if err := s.Client.UpsertSubscriber(ctx, subscriber.Email); err != nil {
+		return fmt.Errorf("newsletter: upsert subscriber %d: %w", subscriber.ID, err)
+	}
+	return nil
+}
+
+// campaignPayload is the outbox payload enqueued by
+// internal/db/event.go: just the event id, matching
+// enqueueGoogleCalendarSyncPayload.
+type campaignPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// HandleDraftCampaign implements jobs.Handler: it decodes payload,
+// re-fetches the event's current state and, if it's published and hasn't
+// already had a campaign drafted, asks the provider to draft one. Unlike
+// HandleSubscriberSync this is dedup-style rather than reconciling: a
+// draft, once created, is left for a human to review and send, so it's
+// never redrafted or updated on a later event edit.
+func (s *Syncer) HandleDraftCampaign(ctx context.Context, payload []byte) error {
+	var p campaignPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("newsletter: unmarshal campaign payload: %w", err)
+	}
+
+	event, err := s.Store.GetEvent(ctx, p.EventID)
+	if err != nil {
+		return fmt.Errorf("newsletter: get event %d: %w", p.EventID, err)
+	}
+	if event.Status != models.StatusPublished {
+		return nil
+	}
+
+	drafted, err := s.Store.HasNewsletterCampaign(ctx, event.ID)
+	if err != nil {
+		return fmt.Errorf("newsletter: has campaign for event %d: %w", event.ID, err)
+	}
+	if drafted {
+		return nil
+	}
+
+	campaignID, err := s.Client.CreateDraftCampaign(ctx, event.Title, event.Notes)
+	if err != nil {
+		return fmt.Errorf("newsletter: create draft campaign for event %d: %w", event.ID, err)
+	}
+	if err := s.Store.RecordNewsletterCampaign(ctx, event.ID, campaignID); err != nil {
+		return fmt.Errorf("newsletter: record campaign for event %d: %w", event.ID, err)
+	}
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)
@@ -0,0 +1,69 @@
+package queryparam
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+)
+
+// FilterOp is a comparison operator recognized in a ?filter[field][op]=
+// query parameter.
+type FilterOp string
+
+// Recognized filter operators. Op defaults to OpEq when a query omits the
+// bracketed operator, e.g. "filter[status]=draft".
+const (
+	OpEq  FilterOp = "eq"
+	OpNe  FilterOp = "ne"
+	OpGt  FilterOp = "gt"
+	OpGte FilterOp = "gte"
+	OpLt  FilterOp = "lt"
+	OpLte FilterOp = "lte"
+)
+
+var validFilterOps = map[FilterOp]bool{
+	OpEq: true, OpNe: true, OpGt: true, OpGte: true, OpLt: true, OpLte: true,
+}
+
+// Filter is one parsed filter[field]= or filter[field][op]= condition.
+type Filter struct {
+	Field string
+	Op    FilterOp
+	Value string
+}
+
+var filterKeyPattern = regexp.MustCompile(`^filter\[([^\]]+)\](?:\[([^\]]+)\])?$`)
+
+// ParseFilters extracts every filter[...] parameter from query into a
+// list of conditions, ordered by field name for a deterministic WHERE
+// clause. It reports an error naming the offending key if a bracketed
+// operator isn't one ParseFilters recognizes, rather than silently
+// dropping it.
+func ParseFilters(query url.Values) ([]Filter, error) {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var filters []Filter
+	for _, key := range keys {
+		m := filterKeyPattern.FindStringSubmatch(key)
+		if m == nil {
+			continue
+		}
+		field, opRaw := m[1], m[2]
+		op := OpEq
+		if opRaw != "" {
+			op = FilterOp(opRaw)
+			if !validFilterOps[op] {
+				return nil, fmt.Errorf("queryparam: unknown filter operator %q on %q", opRaw, field)
+			}
+		}
+		for _, value := range query[key] {
+			filters = append(filters, Filter{Field: field, Op: op, Value: value})
+		}
+	}
+	return filters, nil
+}
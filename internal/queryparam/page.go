@@ -0,0 +1,47 @@
+package queryparam
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// DefaultLimit is the page size a list endpoint uses when ?limit= is
+// omitted.
+const DefaultLimit = 50
+
+// MaxLimit bounds how large a page a caller can request, so a client
+// can't force an unbounded scan by passing an enormous ?limit=.
+const MaxLimit = 200
+
+// Page is a parsed ?limit=&offset= pagination request.
+type Page struct {
+	Limit  int
+	Offset int
+}
+
+// ParsePage parses ?limit= and ?offset= from query, defaulting to
+// DefaultLimit and 0 respectively and capping limit at MaxLimit. It
+// reports an error naming the offending parameter if either value isn't
+// a non-negative integer.
+func ParsePage(query url.Values) (Page, error) {
+	page := Page{Limit: DefaultLimit}
+	if raw := query.Get("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return Page{}, fmt.Errorf("queryparam: invalid limit %q", raw)
+		}
+		page.Limit = n
+	}
+	if page.Limit > MaxLimit {
+		page.Limit = MaxLimit
+	}
+	if raw := query.Get("offset"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return Page{}, fmt.Errorf("queryparam: invalid offset %q", raw)
+		}
+		page.Offset = n
+	}
+	return page, nil
+}
@@ -0,0 +1,48 @@
+// Package queryparam parses list-endpoint query parameters that are
+// shared across resources, so each handler doesn't reinvent its own
+// grammar for the same concept.
+package queryparam
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SortKey is one field named in a ?sort= parameter.
+type SortKey struct {
+	Field string
+	Desc  bool
+}
+
+// ParseSort parses a ?sort= value like "-created_at,title" into an
+// ordered list of keys: a leading '-' means descending, otherwise
+// ascending. Later keys break ties left by earlier ones. Empty input
+// yields no keys.
+func ParseSort(raw string) []SortKey {
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	keys := make([]SortKey, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		desc := strings.HasPrefix(p, "-")
+		keys = append(keys, SortKey{Field: strings.TrimPrefix(p, "-"), Desc: desc})
+	}
+	return keys
+}
+
+// Whitelist reports an error naming the first field in keys that isn't in
+// allowed, so a caller can reject an unsortable or injectable field with a
+// 400 instead of silently ignoring or acting on it.
+func Whitelist(keys []SortKey, allowed map[string]bool) error {
+	for _, k := range keys {
+		if !allowed[k.Field] {
+			return fmt.Errorf("queryparam: field %q is not sortable", k.Field)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Package ratelimit implements a simple in-memory token-bucket limiter
+// keyed by an arbitrary string, such as an authenticated actor's identity.
+// It has no external dependency, matching the rest of this codebase's
+// preference for hand-rolled primitives over adding a new module
+// dependency for something this small.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Config is one quota: Burst requests may be spent immediately, refilling
+// at RequestsPerSecond thereafter.
+type Config struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// Result is what Limiter.Allow reports back, enough to populate
+// X-RateLimit-* response headers regardless of the outcome.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	ResetAt   time.Time
+}
+
+// maxBuckets caps how many distinct keys a Limiter tracks at once. Allow
+// is keyed off caller-supplied strings that aren't necessarily verified
+// identities (an actor header, say), so without a cap a caller that can
+// mint a fresh key on every request could grow buckets without bound.
+const maxBuckets = 10000
+
+// staleAfter is how long a bucket may sit untouched before it's eligible
+// for eviction once the map is full.
+const staleAfter = time.Hour
+
+// Limiter tracks one token bucket per key. The zero value is not usable;
+// construct with New.
+type Limiter struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// New returns an empty Limiter. Quotas are supplied per call to Allow,
+// so the same Limiter can serve callers on different quotas (e.g. a
+// per-role override) without needing one instance per quota.
+func New() *Limiter {
+	return &Limiter{buckets: make(map[string]*bucket)}
+}
+
+// Allow spends one token from key's bucket under cfg, creating the bucket
+// at full burst if key hasn't been seen before. It's safe for concurrent
+// use.
+func (l *Limiter) Allow(key string, cfg Config) Result {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxBuckets {
+			l.evictStale(now)
+		}
+		b = &bucket{tokens: float64(cfg.Burst), updatedAt: now}
+		l.buckets[key] = b
+	} else {
+		elapsed := now.Sub(b.updatedAt).Seconds()
+		b.tokens += elapsed * cfg.RequestsPerSecond
+		if b.tokens > float64(cfg.Burst) {
+			b.tokens = float64(cfg.Burst)
+		}
+		b.updatedAt = now
+	}
+
+	remaining := int(b.tokens)
+	resetAt := now.Add(time.Duration(float64(time.Second) * (float64(cfg.Burst) - b.tokens) / cfg.RequestsPerSecond))
+
+	if b.tokens < 1 {
+		return Result{Allowed: false, Limit: cfg.Burst, Remaining: 0, ResetAt: resetAt}
+	}
+	b.tokens--
+	return Result{Allowed: true, Limit: cfg.Burst, Remaining: remaining - 1, ResetAt: resetAt}
+}
+
+// evictStale removes buckets untouched for over staleAfter. Called with
+// mu already held, once the map has grown large enough that an attacker
+// minting fresh keys could otherwise exhaust memory before any bucket
+// would naturally be reused.
+func (l *Limiter) evictStale(now time.Time) {
+	for k, b := range l.buckets {
+		if now.Sub(b.updatedAt) > staleAfter {
+			delete(l.buckets, k)
+		}
+	}
+}
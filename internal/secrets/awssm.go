@@ -0,0 +1,20 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+)
+
+// AWSSMProvider resolves secrets from AWS Secrets Manager.
+//
+// Like VaultProvider, this defines the interface boundary; wiring in the
+// AWS SDK is left for when a deployment actually needs it.
+type AWSSMProvider struct{}
+
+func NewAWSSMProvider() *AWSSMProvider {
+	return &AWSSMProvider{}
+}
+
+func (p *AWSSMProvider) Get(_ context.Context, name string) (string, error) {
+	return "", fmt.Errorf("secrets: aws-secrets-manager: not yet implemented (name=%s)", name)
+}
@@ -0,0 +1,19 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// EnvProvider resolves secrets from environment variables. It's the
+// default, matching the repo's existing .env-based configuration.
+type EnvProvider struct{}
+
+func (EnvProvider) Get(_ context.Context, name string) (string, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("secrets: env: %s is not set", name)
+	}
+	return v, nil
+}
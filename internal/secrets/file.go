@@ -0,0 +1,25 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileProvider resolves secrets from files mounted under Dir, one secret
+// per file (the convention used by Docker/Kubernetes secret mounts), named
+// after the lowercased secret name.
+type FileProvider struct {
+	Dir string
+}
+
+func (p FileProvider) Get(_ context.Context, name string) (string, error) {
+	path := filepath.Join(p.Dir, strings.ToLower(name))
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("secrets: file: read %s: %w", path, err)
+	}
+	return strings.TrimSpace(string(b)), nil
+}
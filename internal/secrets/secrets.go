@@ -0,0 +1,49 @@
+// Package secrets abstracts where sensitive config values (POSTGRES_PWD,
+// SECRET_KEY, ...) come from, so production doesn't have to keep them in
+// plain .env files.
+package secrets
+
+import "context"
+
+// Provider resolves a named secret to its value.
+type Provider interface {
+	// Get returns the value for name, or an error if it can't be resolved.
+	Get(ctx context.Context, name string) (string, error)
+}
+
+// Kind selects a Provider implementation via config.
+type Kind string
+
+const (
+	KindEnv   Kind = "env"
+	KindFile  Kind = "file"
+	KindVault Kind = "vault"
+	KindAWSSM Kind = "aws-secrets-manager"
+)
+
+// New builds the Provider selected by kind. dir is consulted by KindFile as
+// the mount directory (e.g. /run/secrets); addr is consulted by KindVault
+// as the Vault address.
+func New(kind Kind, dir, addr string) (Provider, error) {
+	switch kind {
+	case "", KindEnv:
+		return EnvProvider{}, nil
+	case KindFile:
+		return FileProvider{Dir: dir}, nil
+	case KindVault:
+		return NewVaultProvider(addr), nil
+	case KindAWSSM:
+		return NewAWSSMProvider(), nil
+	default:
+		return nil, &UnknownKindError{Kind: kind}
+	}
+}
+
+// UnknownKindError is returned by New for an unrecognised Kind.
+type UnknownKindError struct {
+	Kind Kind
+}
+
+func (e *UnknownKindError) Error() string {
+	return "secrets: unknown provider kind " + string(e.Kind)
+}
@@ -0,0 +1,23 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+)
+
+// VaultProvider resolves secrets from a HashiCorp Vault KV mount.
+//
+// This is a thin placeholder: it defines the shape the config layer talks
+// to so a real Vault client can be dropped in without touching call sites,
+// but it doesn't yet perform network I/O.
+type VaultProvider struct {
+	Addr string
+}
+
+func NewVaultProvider(addr string) *VaultProvider {
+	return &VaultProvider{Addr: addr}
+}
+
+func (p *VaultProvider) Get(_ context.Context, name string) (string, error) {
+	return "", fmt.Errorf("secrets: vault: not yet implemented (addr=%s, name=%s)", p.Addr, name)
+}
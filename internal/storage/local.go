@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStore saves files to disk under Dir and serves them back at
+// PublicURL, so a reverse proxy that already serves Dir as static files
+// gets a working link with no extra wiring on this end.
+type LocalStore struct {
+	Dir       string
+	PublicURL string
+}
+
+// Save writes file under a randomly generated name, keeping only file's
+// extension from its original name, so a client-supplied name can't be
+// used to overwrite an existing file or escape Dir.
+func (s *LocalStore) Save(ctx context.Context, file File) (string, error) {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return "", fmt.Errorf("storage: create dir: %w", err)
+	}
+
+	name, err := randomFilename(file.Name)
+	if err != nil {
+		return "", fmt.Errorf("storage: generate filename: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(s.Dir, name), file.Data, 0o644); err != nil {
+		return "", fmt.Errorf("storage: write file: %w", err)
+	}
+
+	return strings.TrimSuffix(s.PublicURL, "/") + "/" + name, nil
+}
+
+// randomFilename builds a filename with 16 random bytes of entropy and
+// original's extension (if any), so concurrent uploads never collide.
+func randomFilename(original string) (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf) + filepath.Ext(original), nil
+}
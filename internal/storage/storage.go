@@ -0,0 +1,54 @@
+// Package storage saves uploaded files to a pluggable Store: local disk
+// for a single-instance deployment, with room to add an S3-backed Store
+// later without touching callers like the event poster upload endpoint.
+package storage
+
+import "context"
+
+// File is a file ready to be saved. Name is only ever used to derive an
+// extension; Store implementations generate their own unique key rather
+// than trusting a client-supplied name.
+type File struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+// Store saves a File and returns the public URL it can be reached at.
+type Store interface {
+	Save(ctx context.Context, file File) (url string, err error)
+}
+
+// Kind selects a Store implementation via config.
+type Kind string
+
+const (
+	KindLocal Kind = "local"
+)
+
+// Config configures whichever Store Kind selects.
+type Config struct {
+	Kind Kind
+
+	LocalDir       string
+	LocalPublicURL string
+}
+
+// New builds the Store selected by cfg.Kind.
+func New(cfg Config) (Store, error) {
+	switch cfg.Kind {
+	case "", KindLocal:
+		return &LocalStore{Dir: cfg.LocalDir, PublicURL: cfg.LocalPublicURL}, nil
+	default:
+		return nil, &UnknownKindError{Kind: cfg.Kind}
+	}
+}
+
+// UnknownKindError is returned by New for an unrecognised Kind.
+type UnknownKindError struct {
+	Kind Kind
+}
+
+func (e *UnknownKindError) Error() string {
+	return "storage: unknown store kind " + string(e.Kind)
+}
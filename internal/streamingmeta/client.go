@@ -0,0 +1,21 @@
+package streamingmeta
+
+import (
+	"context"
+	"time"
+)
+
+// Metadata is the subset of a provider's listing data Fetcher needs to fill
+// in on a recording streaming link.
+type Metadata struct {
+	ArtworkURL  string
+	ReleaseDate *time.Time
+}
+
+// Client is the provider API surface Fetcher depends on, so it can be faked
+// in place of a real implementation. Each streaming provider (Spotify, Apple
+// Music, Bandcamp) gets its own Client behind this same interface, keyed by
+// models.RecordingStreamingLink.Provider.
+type Client interface {
+	FetchMetadata(ctx context.Context, url string) (Metadata, error)
+}
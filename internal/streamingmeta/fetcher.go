@@ -0,0 +1,86 @@
+// Package streamingmeta fills in provider metadata (artwork, release date)
+// on a recording's streaming links, via the outbox: internal/db adds a
+// streaming_metadata_fetch job every time a link is added, and
+// Fetcher.Handle re-fetches that one link's current state before acting on
+// it, so a job that fires after the link has since been deleted is a safe
+// no-op.
+package streamingmeta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Fetcher depends on.
+type Store interface {
+	GetRecordingStreamingLink(ctx context.Context, id int64) (*models.RecordingStreamingLink, error)
+	UpdateRecordingStreamingLinkMetadata(ctx context.Context, id int64, artworkURL string, providerReleaseDate *models.DateTime) error
+}
+
+// Fetcher fetches and persists one streaming link's provider metadata at a
+// time. Unlike googlecalendar.Syncer's mirrored-or-removed choice, fetching
+// metadata is always safe to (re-)do: Clients is keyed by provider so the
+// right one gets used regardless of how many times this fires.
+type Fetcher struct {
+	Store   Store
+	Clients map[string]Client
+}
+
+// fetchPayload is the outbox payload enqueued by
+// internal/db/recordingstreaminglink.go: just the link id, since Handle
+// re-derives everything else from the link's current state.
+type fetchPayload struct {
+	LinkID int64 `json:"link_id"`
+}
+
+// Handle implements jobs.Handler: it decodes payload, re-fetches the link's
+// current state, and asks the client registered for its provider for
+// metadata. A link whose provider has no registered client is logged, and
+// one that no longer exists is dropped without logging; either is treated
+// as done rather than retried, since neither condition will resolve itself
+// on retry.
+func (f *Fetcher) Handle(ctx context.Context, payload []byte) error {
+	var p fetchPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("streamingmeta: unmarshal payload: %w", err)
+	}
+
+	link, err := f.Store.GetRecordingStreamingLink(ctx, p.LinkID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("streamingmeta: get recording streaming link %d: %w", p.LinkID, err)
+	}
+
+	client, ok := f.Clients[link.Provider]
+	if !ok {
+		log.Printf("streamingmeta: no client registered for provider %q, skipping link %d", link.Provider, link.ID)
+		return nil
+	}
+
+	meta, err := client.FetchMetadata(ctx, link.URL)
+	if err != nil {
+		return fmt.Errorf("streamingmeta: fetch metadata for link %d: %w", link.ID, err)
+	}
+
+	var releaseDate *models.DateTime
+	if meta.ReleaseDate != nil {
+		dt := models.DateTime(*meta.ReleaseDate)
+		releaseDate = &dt
+	}
+	if err := f.Store.UpdateRecordingStreamingLinkMetadata(ctx, link.ID, meta.ArtworkURL, releaseDate); err != nil {
+		return fmt.Errorf("streamingmeta: record metadata for link %d: %w", link.ID, err)
+	}
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)
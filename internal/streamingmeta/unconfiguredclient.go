@@ -0,0 +1,22 @@
+package streamingmeta
+
+import (
+	"context"
+	"fmt"
+)
+
+// UnconfiguredClient is a Client that always fails, for a provider whose
+// real API integration hasn't been wired up yet. Registering it (rather
+// than leaving the provider out of Fetcher.Clients) means a link for that
+// provider still shows up as a normal job failure in the dead-letter queue,
+// instead of only being logged and treated as done.
+type UnconfiguredClient struct {
+	Provider string
+}
+
+// FetchMetadata always returns an error naming the unconfigured provider.
+func (c *UnconfiguredClient) FetchMetadata(ctx context.Context, url string) (Metadata, error) {
+	return Metadata{}, fmt.Errorf("streamingmeta: %s client not configured", c.Provider)
+}
+
+var _ Client = (*UnconfiguredClient)(nil)
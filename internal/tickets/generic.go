@@ -0,0 +1,73 @@
+package tickets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// soldOutPhrases are the substrings, matched case-insensitively against a
+// ticket page's body, that most box-office platforms render somewhere on
+// the page once a listing has sold out. There's no vendor-specific
+// integration in this tree, so this is the only signal a GenericProber has
+// to go on.
+var soldOutPhrases = []string{
+	"sold out",
+	"no longer available",
+	"tickets unavailable",
+}
+
+// GenericProber checks a ticket link the same way a human visiting it
+// would: fetch the page and look for wording that says it's sold out.
+// It's a coarse heuristic, but it needs no per-vendor setup, which makes
+// it the right default until a specific provider's API is worth wiring up
+// as its own Prober.
+type GenericProber struct {
+	Client *http.Client
+}
+
+// Probe fetches url and classifies it as sold out, on sale, or unknown if
+// the request itself fails.
+func (p *GenericProber) Probe(ctx context.Context, url string) (models.TicketStatus, error) {
+	client := p.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return models.TicketStatusUnknown, fmt.Errorf("tickets: build request for %s: %w", url, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return models.TicketStatusUnknown, fmt.Errorf("tickets: fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone {
+		return models.TicketStatusSoldOut, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return models.TicketStatusUnknown, fmt.Errorf("tickets: %s returned status %d", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return models.TicketStatusUnknown, fmt.Errorf("tickets: read %s: %w", url, err)
+	}
+
+	lower := strings.ToLower(string(body))
+	for _, phrase := range soldOutPhrases {
+		if strings.Contains(lower, phrase) {
+			return models.TicketStatusSoldOut, nil
+		}
+	}
+	return models.TicketStatusOnSale, nil
+}
+
+var _ Prober = (*GenericProber)(nil)
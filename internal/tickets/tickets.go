@@ -0,0 +1,69 @@
+// Package tickets polls each published event's ticket offers for on-sale
+// or sold-out availability, so the public performances listing can show
+// "Sold out" without a human editing the event by hand.
+package tickets
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Prober checks a single ticket offer's URL and reports the availability
+// it finds there. GenericProber is the only implementation today; a
+// provider-specific one (an API a ticketing vendor exposes, say) would
+// satisfy the same interface. Refresher holds exactly one Prober, so
+// there's no fallback from one implementation to another.
+type Prober interface {
+	Probe(ctx context.Context, url string) (models.TicketStatus, error)
+}
+
+// Store is the persistence contract Refresher depends on.
+type Store interface {
+	ListPublishedTicketOffers(ctx context.Context) ([]models.TicketOffer, error)
+	UpdateTicketOfferStatus(ctx context.Context, id int64, status models.TicketStatus) error
+}
+
+// Refresher polls every published event's ticket offers and records what
+// Prober found.
+type Refresher struct {
+	Store  Store
+	Prober Prober
+}
+
+// Refresh probes every published event's ticket offers and writes back the
+// status it finds. A single offer failing to probe is logged and skipped
+// rather than aborting the rest of the batch, since one vendor's outage
+// shouldn't stall every other offer's status.
+func (r *Refresher) Refresh(ctx context.Context) error {
+	offers, err := r.Store.ListPublishedTicketOffers(ctx)
+	if err != nil {
+		return fmt.Errorf("tickets: list ticket offers: %w", err)
+	}
+
+	var updated int
+	for _, offer := range offers {
+		status, err := r.Prober.Probe(ctx, offer.URL)
+		if err != nil {
+			log.Printf("tickets: probe offer %d (%s): %v", offer.ID, offer.URL, err)
+			continue
+		}
+		if status == offer.Status {
+			continue
+		}
+		if err := r.Store.UpdateTicketOfferStatus(ctx, offer.ID, status); err != nil {
+			log.Printf("tickets: update offer %d: %v", offer.ID, err)
+			continue
+		}
+		updated++
+	}
+	if updated > 0 {
+		log.Printf("tickets: updated ticket status for %d offer(s)", updated)
+	}
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)
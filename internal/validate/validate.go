@@ -0,0 +1,165 @@
+// Package validate provides declarative validation for request structs via
+// `validate:"..."` struct tags, so a field's constraints live next to the
+// field itself instead of as hand-written checks scattered through the db
+// layer.
+package validate
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError describes one failed validation rule.
+type FieldError struct {
+	Field string
+	Rule  string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Rule)
+}
+
+// Errors collects every FieldError Struct found, in field order.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Struct validates v's exported fields against their `validate:"..."`
+// struct tags and returns an Errors listing every rule that failed, or
+// nil if v satisfies all of them. v may be a struct or a pointer to one.
+//
+// Supported rules, comma-separated within a tag:
+//
+//	required   the field must be present and non-zero
+//	max=N      a string may be at most N runes, a number at most N
+//	url        a non-empty string must parse as an absolute URL
+//	oneof=a|b  a non-empty string must equal one of the listed options
+//
+// A field whose type looks like models.Optional[T] (it has bool Set and
+// Null fields alongside a Value field) is only checked against max/url/
+// oneof when it was actually set to a non-null value; required instead
+// fails when it wasn't.
+func Struct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs Errors
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag := field.Tag.Get("validate")
+		if tag == "" || !field.IsExported() {
+			continue
+		}
+
+		present, value := unwrapOptional(rv.Field(i))
+		for _, rule := range strings.Split(tag, ",") {
+			name, arg, _ := strings.Cut(rule, "=")
+			var ok bool
+			switch name {
+			case "required":
+				ok = present && !isZero(value)
+			case "max":
+				ok = !present || withinMax(value, arg)
+			case "url":
+				ok = !present || isURL(value)
+			case "oneof":
+				ok = !present || isOneOf(value, arg)
+			default:
+				ok = true
+			}
+			if !ok {
+				errs = append(errs, FieldError{Field: jsonName(field), Rule: rule})
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// unwrapOptional reports whether fv should be treated as present for
+// validation, and the value to run rules against. For a plain field
+// that's always (true, fv); for something shaped like models.Optional[T]
+// it's (Set && !Null, Value).
+func unwrapOptional(fv reflect.Value) (bool, reflect.Value) {
+	if fv.Kind() == reflect.Struct {
+		set := fv.FieldByName("Set")
+		null := fv.FieldByName("Null")
+		value := fv.FieldByName("Value")
+		if set.Kind() == reflect.Bool && null.Kind() == reflect.Bool && value.IsValid() {
+			return set.Bool() && !null.Bool(), value
+		}
+	}
+	return true, fv
+}
+
+// isZero reports whether v is its type's zero value, preferring an
+// IsZero method (as time.Time and models.Date/DateTime have) over a
+// field-by-field reflect comparison.
+func isZero(v reflect.Value) bool {
+	if v.CanInterface() {
+		if iz, ok := v.Interface().(interface{ IsZero() bool }); ok {
+			return iz.IsZero()
+		}
+	}
+	return v.IsZero()
+}
+
+func withinMax(v reflect.Value, arg string) bool {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return true
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return len([]rune(v.String())) <= n
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() <= int64(n)
+	default:
+		return true
+	}
+}
+
+func isURL(v reflect.Value) bool {
+	if v.Kind() != reflect.String || v.String() == "" {
+		return true
+	}
+	u, err := url.ParseRequestURI(v.String())
+	return err == nil && u.Scheme != "" && u.Host != ""
+}
+
+func isOneOf(v reflect.Value, arg string) bool {
+	if v.Kind() != reflect.String || v.String() == "" {
+		return true
+	}
+	for _, opt := range strings.Split(arg, "|") {
+		if v.String() == opt {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
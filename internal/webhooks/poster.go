@@ -0,0 +1,92 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Poster depends on.
+type Store interface {
+	GetEvent(ctx context.Context, id int64) (*models.Event, error)
+	ListWebhookChannels(ctx context.Context) ([]models.WebhookChannel, error)
+	HasWebhookPost(ctx context.Context, eventID, channelID int64) (bool, error)
+	RecordWebhookPost(ctx context.Context, eventID, channelID int64) error
+}
+
+// Poster sends a social post to every webhook channel when an event is
+// published. Unlike googlecalendar.Syncer, a post can't be un-sent, so
+// Poster tracks what it has already sent in webhook_posts rather than
+// reconciling toward the event's current state on every run: once an event
+// is posted to a channel, it stays posted even if the event is later edited
+// or unpublished.
+type Poster struct {
+	Store  Store
+	Sender Sender
+}
+
+// postPayload is the outbox payload enqueued by internal/db/event.go: just
+// the event id, matching googlecalendar's syncPayload.
+type postPayload struct {
+	EventID int64 `json:"event_id"`
+}
+
+// Handle implements jobs.Handler: it decodes payload, and, if the event is
+// currently published, posts to every channel that hasn't already received
+// a post for it. A channel that fails to send is logged and skipped rather
+// than aborting the others, so one bad webhook URL doesn't block the rest.
+func (p *Poster) Handle(ctx context.Context, payload []byte) error {
+	var pl postPayload
+	if err := json.Unmarshal(payload, &pl); err != nil {
+		return fmt.Errorf("webhooks: unmarshal payload: %w", err)
+	}
+
+	event, err := p.Store.GetEvent(ctx, pl.EventID)
+	if err != nil {
+		return fmt.Errorf("webhooks: get event %d: %w", pl.EventID, err)
+	}
+	if event.Status != models.StatusPublished {
+		return nil
+	}
+
+	channels, err := p.Store.ListWebhookChannels(ctx)
+	if err != nil {
+		return fmt.Errorf("webhooks: list channels: %w", err)
+	}
+
+	for _, channel := range channels {
+		if err := p.postTo(ctx, &channel, event); err != nil {
+			log.Printf("webhooks: post event %d to channel %d: %v", event.ID, channel.ID, err)
+		}
+	}
+	return nil
+}
+
+// postTo sends event's post to channel, unless it's already been sent.
+func (p *Poster) postTo(ctx context.Context, channel *models.WebhookChannel, event *models.Event) error {
+	posted, err := p.Store.HasWebhookPost(ctx, event.ID, channel.ID)
+	if err != nil {
+		return fmt.Errorf("check post status: %w", err)
+	}
+	if posted {
+		return nil
+	}
+
+	payload, err := Render(channel, event)
+	if err != nil {
+		return fmt.Errorf("render payload: %w", err)
+	}
+	if err := p.Sender.Send(ctx, channel.URL, payload); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+	if err := p.Store.RecordWebhookPost(ctx, event.ID, channel.ID); err != nil {
+		return fmt.Errorf("record post: %w", err)
+	}
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)
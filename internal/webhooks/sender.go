@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Sender delivers a payload rendered by Render (a Payload or a
+// FlatPayload) to a channel's URL, so Poster can be tested against a fake
+// without making a real HTTP call.
+type Sender interface {
+	Send(ctx context.Context, url string, payload interface{}) error
+}
+
+// HTTPSender is a Sender backed by a plain JSON POST to url. It doesn't know
+// or care what's listening there; social automation tools (Zapier, IFTTT,
+// Buffer, and the like) are expected to be configured to accept this shape.
+type HTTPSender struct {
+	Client *http.Client
+}
+
+func (s *HTTPSender) Send(ctx context.Context, url string, payload interface{}) error {
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("webhooks: marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhooks: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhooks: post to %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhooks: post to %s: status %d", url, resp.StatusCode)
+	}
+	return nil
+}
+
+var _ Sender = (*HTTPSender)(nil)
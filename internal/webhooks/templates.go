@@ -0,0 +1,114 @@
+// Package webhooks renders and sends the social post payload for a
+// published event out to every configured webhook channel, via the outbox:
+// internal/db/event.go enqueues a job on every event update, and
+// Poster.Handle reconciles which channels still need a post for that event.
+package webhooks
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// TemplateData is what a channel's templates are rendered against.
+type TemplateData struct {
+	Title     string
+	EventDate string
+}
+
+// Payload is the rendered social post for one channel: a caption, an
+// optional image, and a link back to the event. It's what a channel
+// with PayloadMode PayloadModeTemplate receives.
+type Payload struct {
+	Text     string `json:"text"`
+	ImageURL string `json:"image_url,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+// FlatPayload is what a channel with PayloadMode PayloadModeFlat
+// receives instead of Payload: a fixed set of field names carrying the
+// event's own data directly, with no per-channel template step a no-code
+// tool like Zapier would otherwise have to be taught to parse.
+type FlatPayload struct {
+	Event     string `json:"event"`
+	Title     string `json:"title"`
+	Slug      string `json:"slug"`
+	EventDate string `json:"event_date"`
+	Notes     string `json:"notes,omitempty"`
+}
+
+// flatPayloadEvent names the kind of thing FlatPayload describes, so a
+// channel handling more than one webhook can branch on it without
+// inspecting the rest of the fields.
+const flatPayloadEvent = "event.published"
+
+// Render fills in channel's payload for event: FlatPayload if
+// channel.PayloadMode is PayloadModeFlat, or channel's own templates
+// rendered into a Payload otherwise. The return type is interface{}
+// (either Payload or FlatPayload) since Sender.Send just marshals
+// whatever it's given as the request body.
+func Render(channel *models.WebhookChannel, event *models.Event) (interface{}, error) {
+	if channel.PayloadMode == models.PayloadModeFlat {
+		return FlatPayload{
+			Event:     flatPayloadEvent,
+			Title:     event.Title,
+			Slug:      event.Slug,
+			EventDate: event.EventDate.Time().Format("2006-01-02"),
+			Notes:     event.Notes,
+		}, nil
+	}
+
+	data := TemplateData{
+		Title:     event.Title,
+		EventDate: event.EventDate.Time().Format("2006-01-02"),
+	}
+
+	text, err := renderTemplate(channel.TextTemplate, data)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: render text template: %w", err)
+	}
+	imageURL, err := renderTemplate(channel.ImageURLTemplate, data)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: render image url template: %w", err)
+	}
+	link, err := renderTemplate(channel.LinkTemplate, data)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks: render link template: %w", err)
+	}
+	return Payload{Text: text, ImageURL: imageURL, Link: link}, nil
+}
+
+// exampleEvent is a fabricated event RenderExample renders channel's
+// payload against, so a test delivery matches exactly what publishing a
+// real event would send, without requiring one to exist yet.
+var exampleEvent = &models.Event{
+	Slug:      "example-event",
+	Title:     "Example Concert",
+	EventDate: models.Date(time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	Notes:     "This is a test delivery from ntumiwa.",
+}
+
+// RenderExample renders channel's payload against exampleEvent, for the
+// webhook channel test-delivery endpoint: an admin can confirm a channel
+// is wired up correctly before waiting for a real event to publish.
+func RenderExample(channel *models.WebhookChannel) (interface{}, error) {
+	return Render(channel, exampleEvent)
+}
+
+func renderTemplate(text string, data TemplateData) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+	tmpl, err := template.New("").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,139 @@
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// videosAPI is the YouTube Data API v3 endpoint for looking up a video by
+// id. APIClient talks to it directly rather than through Google's Go
+// client library, to avoid pulling its dependency tree in for one call.
+const videosAPI = "https://www.googleapis.com/youtube/v3/videos"
+
+// APIClient is a Client backed by the real YouTube Data API v3.
+type APIClient struct {
+	APIKey string
+	Client *http.Client
+}
+
+type videosResponse struct {
+	Items []struct {
+		Snippet struct {
+			Title      string `json:"title"`
+			Thumbnails struct {
+				High struct {
+					URL string `json:"url"`
+				} `json:"high"`
+			} `json:"thumbnails"`
+		} `json:"snippet"`
+		ContentDetails struct {
+			Duration string `json:"duration"`
+		} `json:"contentDetails"`
+	} `json:"items"`
+}
+
+// FetchMetadata looks up videoURL's video id and returns its title,
+// duration and thumbnail.
+func (c *APIClient) FetchMetadata(ctx context.Context, videoURL string) (Metadata, error) {
+	videoID, err := extractVideoID(videoURL)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("youtube: %w", err)
+	}
+
+	q := url.Values{
+		"part": {"snippet,contentDetails"},
+		"id":   {videoID},
+		"key":  {c.APIKey},
+	}
+	reqURL := videosAPI + "?" + q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("youtube: build request: %w", err)
+	}
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("youtube: do request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("youtube: read response: %w", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return Metadata{}, fmt.Errorf("youtube: status %d: %s", resp.StatusCode, body)
+	}
+
+	var out videosResponse
+	if err := json.Unmarshal(body, &out); err != nil {
+		return Metadata{}, fmt.Errorf("youtube: parse response: %w", err)
+	}
+	if len(out.Items) == 0 {
+		return Metadata{}, fmt.Errorf("youtube: video %s not found", videoID)
+	}
+
+	item := out.Items[0]
+	duration, err := parseISO8601Duration(item.ContentDetails.Duration)
+	if err != nil {
+		return Metadata{}, fmt.Errorf("youtube: parse duration for video %s: %w", videoID, err)
+	}
+
+	return Metadata{
+		Title:           item.Snippet.Title,
+		DurationSeconds: duration,
+		ThumbnailURL:    item.Snippet.Thumbnails.High.URL,
+	}, nil
+}
+
+// videoIDPattern matches the video id out of the handful of URL shapes a
+// video embed URL actually shows up as: a watch URL's v query param, or
+// the trailing path segment of a youtu.be or /embed/ URL.
+var videoIDPattern = regexp.MustCompile(`(?:youtu\.be/|youtube\.com/embed/|[?&]v=)([\w-]{11})`)
+
+// extractVideoID pulls the 11-character video id out of videoURL.
+func extractVideoID(videoURL string) (string, error) {
+	if m := videoIDPattern.FindStringSubmatch(videoURL); m != nil {
+		return m[1], nil
+	}
+	return "", fmt.Errorf("could not extract video id from %q", videoURL)
+}
+
+// iso8601DurationPattern matches the PT#H#M#S shape the YouTube Data API
+// returns durations in. Any of the three components may be absent.
+var iso8601DurationPattern = regexp.MustCompile(`^PT(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?$`)
+
+// parseISO8601Duration converts an ISO8601 duration like "PT4M13S" into a
+// whole number of seconds.
+func parseISO8601Duration(s string) (int32, error) {
+	m := iso8601DurationPattern.FindStringSubmatch(s)
+	if m == nil {
+		return 0, fmt.Errorf("unrecognized duration %q", s)
+	}
+	var total int64
+	for i, unit := range []int64{3600, 60, 1} {
+		part := m[i+1]
+		if part == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(part, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("unrecognized duration %q", s)
+		}
+		total += n * unit
+	}
+	return int32(total), nil
+}
+
+var _ Client = (*APIClient)(nil)
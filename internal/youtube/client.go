@@ -0,0 +1,17 @@
+package youtube
+
+import "context"
+
+// Metadata is the subset of a YouTube video's data Fetcher persists onto an
+// EventMedia row.
+type Metadata struct {
+	Title           string
+	DurationSeconds int32
+	ThumbnailURL    string
+}
+
+// Client is the YouTube Data API surface Fetcher depends on, so it can be
+// faked in place of a real APIClient.
+type Client interface {
+	FetchMetadata(ctx context.Context, videoURL string) (Metadata, error)
+}
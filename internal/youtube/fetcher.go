@@ -0,0 +1,75 @@
+// Package youtube fills in title, duration and thumbnail on an event's
+// video embed, via the outbox: internal/db adds a video_metadata_fetch job
+// every time a MediaKindVideo row is created, and Fetcher.Handle re-fetches
+// that one row's current state before acting on it, so a job that fires
+// after the row has since been replaced (EventMedia rows are recreated on
+// every event save) is a safe no-op.
+package youtube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/adamkadda/ntumiwa/internal/db"
+	"github.com/adamkadda/ntumiwa/internal/models"
+)
+
+// Store is the persistence contract Fetcher depends on.
+type Store interface {
+	GetEventMedia(ctx context.Context, id int64) (*models.EventMedia, error)
+	UpdateEventMediaMetadata(ctx context.Context, id int64, title string, durationSeconds *int32, thumbnailURL string) error
+}
+
+// Fetcher fetches and persists one video embed's metadata at a time.
+// Re-fetching is always safe: EventMedia rows churn on every event save,
+// so a job that fires late just ends up re-fetching for whatever row now
+// holds that id, or finding it gone and doing nothing.
+type Fetcher struct {
+	Store  Store
+	Client Client
+}
+
+// fetchPayload is the outbox payload enqueued by
+// internal/db/eventmedia.go: just the event media id, since Handle
+// re-derives everything else from the row's current state.
+type fetchPayload struct {
+	EventMediaID int64 `json:"event_media_id"`
+}
+
+// Handle implements jobs.Handler: it decodes payload, re-fetches the row's
+// current state, and asks Client for metadata about its URL. A row that no
+// longer exists, or is no longer a video, is treated as done rather than
+// retried, since neither condition will resolve itself on retry.
+func (f *Fetcher) Handle(ctx context.Context, payload []byte) error {
+	var p fetchPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("youtube: unmarshal payload: %w", err)
+	}
+
+	media, err := f.Store.GetEventMedia(ctx, p.EventMediaID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("youtube: get event media %d: %w", p.EventMediaID, err)
+	}
+	if media.Kind != models.MediaKindVideo {
+		return nil
+	}
+
+	meta, err := f.Client.FetchMetadata(ctx, media.URL)
+	if err != nil {
+		return fmt.Errorf("youtube: fetch metadata for event media %d: %w", media.ID, err)
+	}
+
+	if err := f.Store.UpdateEventMediaMetadata(ctx, media.ID, meta.Title, &meta.DurationSeconds, meta.ThumbnailURL); err != nil {
+		return fmt.Errorf("youtube: record metadata for event media %d: %w", media.ID, err)
+	}
+	return nil
+}
+
+var _ Store = (*db.DB)(nil)